@@ -0,0 +1,50 @@
+package dzi
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// assetFolders return temporary root and folder of the processed asset for operations on stored artifacts.
+// Debug mode works with artifacts left by Processing in _tmp.
+func assetFolders(assetId int, c *Config) (string, string) {
+	var _tmp = "_tmp"
+	if !c.DebugMode {
+		_tmp = strings.TrimSuffix(os.TempDir(), "/")
+	}
+	return _tmp, path.Join(_tmp, strconv.Itoa(assetId))
+}
+
+// loadManifest read manifest.json of the processed asset, it is fetched from S3 unless debug mode
+func loadManifest(assetId int, tmp string, c *Config) (*Manifest, error) {
+	if !c.DebugMode {
+		if err := fetchFromS3(assetId, tmp, c, "manifest.json"); err != nil {
+			return nil, err
+		}
+	}
+	buff, err := os.ReadFile(path.Join(tmp, "manifest.json"))
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(Manifest)
+	if err = json.Unmarshal(buff, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// saveManifest bump revision of the changed manifest and write it to manifest.json
+func saveManifest(manifest *Manifest, tmp string) error {
+	manifest.Revision = max(manifest.Revision, 1) + 1
+	manifest.TimestampUpdate = time.Now().Format("2006-01-02 15:04:05")
+
+	buff, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(tmp, "manifest.json"), buff, 0777)
+}
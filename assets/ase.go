@@ -0,0 +1,200 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	aseBlockGroupStart = 0xc001
+	aseBlockGroupEnd   = 0xc002
+	aseBlockColor      = 0x0001
+)
+
+// ParseASE read Adobe Swatch Exchange file, groups are flattened
+func ParseASE(buffer []byte, title string) (*Book, error) {
+	r := bytes.NewReader(buffer)
+
+	var header struct {
+		Signature [4]byte
+		Major     uint16
+		Minor     uint16
+		Blocks    uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, err
+	}
+	if string(header.Signature[:]) != "ASEF" {
+		return nil, errors.New("not an ASE file")
+	}
+
+	book := &Book{Title: title, ColorModel: ColorModelLab}
+	for i := uint32(0); i < header.Blocks; i++ {
+		var blockType uint16
+		var blockLength uint32
+		if err := binary.Read(r, binary.BigEndian, &blockType); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(r, binary.BigEndian, &blockLength); err != nil {
+			return nil, err
+		}
+		block := make([]byte, blockLength)
+		if _, err := io.ReadFull(r, block); err != nil {
+			return nil, err
+		}
+		if blockType != aseBlockColor {
+			continue
+		}
+		color, err := parseASEColor(block)
+		if err != nil {
+			return nil, err
+		}
+		if color != nil {
+			book.Colors = append(book.Colors, color)
+		}
+	}
+
+	if err := book.prepare(); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func parseASEColor(block []byte) (*BookColor, error) {
+	r := bytes.NewReader(block)
+
+	var nameLength uint16
+	if err := binary.Read(r, binary.BigEndian, &nameLength); err != nil {
+		return nil, err
+	}
+	name := make([]uint16, nameLength)
+	if err := binary.Read(r, binary.BigEndian, name); err != nil {
+		return nil, err
+	}
+	if nameLength > 0 && name[nameLength-1] == 0 {
+		name = name[:nameLength-1]
+	}
+
+	var model [4]byte
+	if err := binary.Read(r, binary.BigEndian, &model); err != nil {
+		return nil, err
+	}
+
+	color := &BookColor{Name: string(utf16.Decode(name))}
+	var values []float32
+	switch string(model[:]) {
+	case "LAB ":
+		values = make([]float32, 3)
+		color.ColorModel = ColorModelLab
+	case "RGB ":
+		values = make([]float32, 3)
+		color.ColorModel = ColorModelRGB
+	case "CMYK":
+		values = make([]float32, 4)
+		color.ColorModel = ColorModelCMYK
+	case "Gray":
+		values = make([]float32, 1)
+		color.ColorModel = ColorModelRGB
+	default:
+		return nil, fmt.Errorf("%s: unsupported color model %q", color.Name, model)
+	}
+	if err := binary.Read(r, binary.BigEndian, values); err != nil {
+		return nil, err
+	}
+
+	switch string(model[:]) {
+	case "LAB ":
+		// L is stored as 0..1
+		color.Components = []float64{float64(values[0]) * 100, float64(values[1]), float64(values[2])}
+	case "RGB ":
+		color.Components = []float64{float64(values[0]) * 255, float64(values[1]) * 255, float64(values[2]) * 255}
+	case "CMYK":
+		for _, v := range values {
+			color.Components = append(color.Components, float64(v)*100)
+		}
+	case "Gray":
+		// Gray is stored as lightness, 1 is white
+		v := float64(values[0]) * 255
+		color.Components = []float64{v, v, v}
+	}
+	return color, nil
+}
+
+const (
+	aseColorSpot   = 1
+	aseColorNormal = 2
+)
+
+// EncodeASE write book as Adobe Swatch Exchange file in a single group.
+// CMYK colors are written as process colors. Spot colors are written in CMYK when their CMYK
+// alternate is known, otherwise in Lab.
+func EncodeASE(book *Book) ([]byte, error) {
+	var blocks [][]byte
+	blocks = append(blocks, aseBlock(aseBlockGroupStart, aseName(book.Title)))
+	for _, color := range book.Colors {
+		var body bytes.Buffer
+		body.Write(aseName(color.Name))
+
+		var model string
+		var values []float32
+		var colorType uint16
+		if len(color.CMYK) == 4 {
+			model, colorType = "CMYK", aseColorSpot
+			if strings.EqualFold(color.ColorModel, ColorModelCMYK) {
+				colorType = aseColorNormal
+			}
+			for _, v := range color.CMYK {
+				values = append(values, float32(v/100))
+			}
+		} else {
+			model, colorType = "LAB ", aseColorSpot
+			values = []float32{float32(color.Lab[0] / 100), float32(color.Lab[1]), float32(color.Lab[2])}
+		}
+		body.WriteString(model)
+		if err := binary.Write(&body, binary.BigEndian, values); err != nil {
+			return nil, err
+		}
+		if err := binary.Write(&body, binary.BigEndian, colorType); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, aseBlock(aseBlockColor, body.Bytes()))
+	}
+	blocks = append(blocks, aseBlock(aseBlockGroupEnd, nil))
+
+	var buffer bytes.Buffer
+	buffer.WriteString("ASEF")
+	header := []any{uint16(1), uint16(0), uint32(len(blocks))}
+	for _, v := range header {
+		if err := binary.Write(&buffer, binary.BigEndian, v); err != nil {
+			return nil, err
+		}
+	}
+	for _, block := range blocks {
+		buffer.Write(block)
+	}
+	return buffer.Bytes(), nil
+}
+
+// aseName encode name as length prefixed null terminated UTF-16BE string
+func aseName(name string) []byte {
+	encoded := append(utf16.Encode([]rune(name)), 0)
+	buffer := make([]byte, 2+len(encoded)*2)
+	binary.BigEndian.PutUint16(buffer, uint16(len(encoded)))
+	for i, v := range encoded {
+		binary.BigEndian.PutUint16(buffer[2+i*2:], v)
+	}
+	return buffer
+}
+
+func aseBlock(blockType uint16, body []byte) []byte {
+	buffer := make([]byte, 6+len(body))
+	binary.BigEndian.PutUint16(buffer, blockType)
+	binary.BigEndian.PutUint32(buffer[2:], uint32(len(body)))
+	copy(buffer[6:], body)
+	return buffer
+}
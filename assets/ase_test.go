@@ -0,0 +1,60 @@
+package assets
+
+import (
+	"math"
+	"testing"
+)
+
+func TestASERoundTrip(t *testing.T) {
+	// A single ASE entry keeps one color model: colors with known CMYK are written and read back in CMYK,
+	// other colors in Lab
+	colors := []*BookColor{
+		{Name: "PANTONE 185 C", ColorModel: ColorModelLab, Lab: []float64{47.5, 72.61, 42.9}},
+		{Name: "Белила", ColorModel: ColorModelLab, Lab: []float64{95.02, -0.5, 2.25}},
+		{Name: "Cyan", ColorModel: ColorModelCMYK, Lab: []float64{55, -37, -50}, CMYK: []float64{100, 0, 0, 0}},
+		{Name: "Лак ВД", ColorModel: ColorModelLab, Lab: []float64{52.1, 60.3, 30.8}, CMYK: []float64{0, 91, 76, 6.5}},
+	}
+	buffer, err := EncodeASE(&Book{Title: "Swatches", Colors: colors})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	book, err := ParseASE(buffer, "Swatches")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(book.Colors) != len(colors) {
+		t.Fatalf("got %d colors, want %d", len(book.Colors), len(colors))
+	}
+
+	for idx, want := range colors {
+		got := book.Colors[idx]
+		if got.Name != want.Name {
+			t.Errorf("color %d: name %q, want %q", idx, got.Name, want.Name)
+		}
+		if len(want.CMYK) == 4 {
+			if got.ColorModel != ColorModelCMYK || !almostEqual(got.CMYK, want.CMYK, 1e-4) {
+				t.Errorf("%s: %s %v, want CMYK %v", want.Name, got.ColorModel, got.CMYK, want.CMYK)
+			}
+			continue
+		}
+		if got.ColorModel != ColorModelLab || !almostEqual(got.Lab, want.Lab, 1e-4) {
+			t.Errorf("%s: %s %v, want Lab %v", want.Name, got.ColorModel, got.Lab, want.Lab)
+		}
+		if got.CMYK != nil {
+			t.Errorf("%s: unexpected CMYK %v", want.Name, got.CMYK)
+		}
+	}
+}
+
+func almostEqual(a, b []float64, epsilon float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > epsilon {
+			return false
+		}
+	}
+	return true
+}
@@ -1,33 +1,336 @@
 package assets
 
 import (
-	_ "embed"
+	"cmp"
+	"embed"
 	"encoding/json"
-	dzi "github.com/brandquad/dzi/colorutils"
+	"fmt"
 	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
 	"strings"
+
+	dzi "github.com/brandquad/dzi/colorutils"
+)
+
+const (
+	ColorModelLab  = "Lab"
+	ColorModelRGB  = "RGB"
+	ColorModelCMYK = "CMYK"
 )
 
-type pantoneColor struct {
-	Name          string    `json:"name"`
-	LabComponents []float64 `json:"components"`
+type BookColor struct {
+	Name       string    `json:"name"`
+	Code       string    `json:"code,omitempty"`
+	Components []float64 `json:"components"`
+	ColorModel string    `json:"colorModel,omitempty"`
+	CMYK       []float64 `json:"cmyk,omitempty"`
+	Lab        []float64 `json:"-"`
+	RGB        []int     `json:"-"`
+}
+
+// Hex return preview color of the book color
+func (c *BookColor) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.RGB[0], c.RGB[1], c.RGB[2])
+}
+
+// Book is a color library, components of colors are interpreted by ColorModel of the color or the book:
+// Lab as L*a*b*, RGB as 0-255 sRGB, CMYK as percents.
+// Custom books are customer libraries, their colors take precedence over document metadata.
+type Book struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	ColorModel  string       `json:"colorModel"`
+	Process     bool         `json:"process,omitempty"`
+	Colors      []*BookColor `json:"colors"`
+	Custom      bool         `json:"-"`
+
+	index map[string]*BookColor
+	labs  []dzi.LabColor
 }
-type pantoneData struct {
-	Colors []pantoneColor `json:"colors"`
+
+// Match is a library color with its distance to the requested color
+type Match struct {
+	Color   *BookColor
+	Library string
+	DeltaE  float64
 }
 
-//go:embed pantones.json
-var pantonesData []byte
+//go:embed books/*.json
+var booksFS embed.FS
 
-var Pantones map[string][]int
+// embeddedBooks embedded books in lookup order. Only Pantone Solid Coated 2024 has measured Lab,
+// uncoated and legacy books are sRGB approximations. Pantone M, metallics, pastels, HKS and RAL
+// are not embedded, they are loaded as external books.
+var embeddedBooks = []string{
+	"pantone-solid-coated-2024.json",
+	"process.json",
+	"pantone-solid-uncoated.json",
+	"pantone-solid-coated-legacy.json",
+}
+
+// Books embedded color libraries, shared by all registries
+var Books []*Book
 
 func init() {
-	var j pantoneData
-	if err := json.Unmarshal(pantonesData, &j); err != nil {
-		log.Fatal(err)
+	for _, name := range embeddedBooks {
+		buffer, err := booksFS.ReadFile(path.Join("books", name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		book, err := ParseBook(buffer)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		Books = append(Books, book)
+	}
+}
+
+// ParseBook parse book JSON and compute Lab and RGB values of colors
+func ParseBook(buffer []byte) (*Book, error) {
+	var book Book
+	if err := json.Unmarshal(buffer, &book); err != nil {
+		return nil, err
+	}
+	if err := book.prepare(); err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+func (b *Book) prepare() error {
+	b.index = make(map[string]*BookColor, len(b.Colors))
+	b.labs = make([]dzi.LabColor, 0, len(b.Colors))
+	for _, color := range b.Colors {
+		colorModel := color.ColorModel
+		if colorModel == "" {
+			colorModel = b.ColorModel
+		}
+		if err := color.prepare(colorModel); err != nil {
+			return fmt.Errorf("%s: %w", color.Name, err)
+		}
+		b.labs = append(b.labs, dzi.LabColor{Name: color.Name, Lab: color.Lab})
+		key := strings.ToLower(color.Name)
+		if _, ok := b.index[key]; !ok {
+			b.index[key] = color
+		}
+	}
+	return nil
+}
+
+func (c *BookColor) prepare(colorModel string) error {
+	switch {
+	case strings.EqualFold(colorModel, ColorModelLab):
+		if len(c.Components) != 3 {
+			return fmt.Errorf("expected 3 components, got %d", len(c.Components))
+		}
+		c.Lab = c.Components
+		c.RGB = dzi.Lab2rgb(c.Components)
+	case strings.EqualFold(colorModel, ColorModelRGB):
+		if len(c.Components) != 3 {
+			return fmt.Errorf("expected 3 components, got %d", len(c.Components))
+		}
+		c.RGB = []int{int(c.Components[0]), int(c.Components[1]), int(c.Components[2])}
+		c.Lab = dzi.Rgb2lab(c.RGB)
+	case strings.EqualFold(colorModel, ColorModelCMYK):
+		if len(c.Components) != 4 {
+			return fmt.Errorf("expected 4 components, got %d", len(c.Components))
+		}
+		c.RGB = dzi.Cmyk2rgb(c.Components)
+		c.Lab = dzi.Rgb2lab(c.RGB)
+		c.CMYK = c.Components
+	default:
+		return fmt.Errorf("unsupported color model %q", colorModel)
+	}
+	return nil
+}
+
+// LoadBook read book from JSON, Adobe Swatch Exchange (.ase) or CxF (.cxf) file
+func LoadBook(file string) (*Book, error) {
+	buffer, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	title := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+
+	var book *Book
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".ase":
+		book, err = ParseASE(buffer, title)
+	case ".cxf":
+		book, err = ParseCxF(buffer, title)
+	default:
+		book, err = ParseBook(buffer)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return book, nil
+}
+
+// Registry is an ordered set of books, first book with the color wins on lookup
+type Registry struct {
+	Books      []*Book
+	Normalizer *Normalizer
+
+	normIndex map[string][]normEntry
+}
+
+type normEntry struct {
+	color      *BookColor
+	book       *Book
+	confidence float64
+}
+
+// NameMatch is a color found by name with confidence of the name match from 0 to 1
+type NameMatch struct {
+	Color      *BookColor
+	Book       *Book
+	Confidence float64
+}
+
+// bookExtensions supported external book files
+var bookExtensions = []string{".json", ".ase", ".cxf"}
+
+// NewRegistry return registry with custom books from the folder before embedded books.
+// Empty folder means embedded books only.
+func NewRegistry(folder string, normalizer *Normalizer) (*Registry, error) {
+	r := &Registry{Normalizer: normalizer}
+	if folder != "" {
+		files, err := os.ReadDir(folder)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if file.IsDir() || !slices.Contains(bookExtensions, strings.ToLower(filepath.Ext(file.Name()))) {
+				continue
+			}
+			book, err := LoadBook(path.Join(folder, file.Name()))
+			if err != nil {
+				return nil, err
+			}
+			book.Custom = true
+			log.Printf("[*] Color book %q loaded from %s", book.Title, file.Name())
+			r.Books = append(r.Books, book)
+		}
+	}
+	r.Books = append(r.Books, Books...)
+	r.reindex()
+	return r, nil
+}
+
+// Import load customer library file and put it in front of registry books
+func (r *Registry) Import(file string) error {
+	book, err := LoadBook(file)
+	if err != nil {
+		return err
+	}
+	book.Custom = true
+	log.Printf("[*] Color book %q imported from %s, %d colors", book.Title, file, len(book.Colors))
+	r.Books = append([]*Book{book}, r.Books...)
+	r.reindex()
+	return nil
+}
+
+// reindex build normalized names index of all books in registry order
+func (r *Registry) reindex() {
+	r.normIndex = make(map[string][]normEntry)
+	for _, book := range r.Books {
+		for _, color := range book.Colors {
+			key, confidence := r.Normalizer.Key(color.Name)
+			if key == "" {
+				continue
+			}
+			r.normIndex[key] = append(r.normIndex[key], normEntry{color: color, book: book, confidence: confidence})
+		}
+	}
+}
+
+// Lookup find color by name and return it with the book
+func (r *Registry) Lookup(name string) *NameMatch {
+	return r.lookup(name, func(*Book) bool { return true })
+}
+
+// LookupSpot find color by name in spot color books only
+func (r *Registry) LookupSpot(name string) *NameMatch {
+	return r.lookup(name, func(book *Book) bool { return !book.Process })
+}
+
+// LookupCustom find color by name in customer books only
+func (r *Registry) LookupCustom(name string) *NameMatch {
+	return r.lookup(name, func(book *Book) bool { return book.Custom })
+}
+
+// lookup try exact (case-insensitive) name, then names alias table, then normalized name,
+// then normalized name with default suffix
+func (r *Registry) lookup(name string, filter func(*Book) bool) *NameMatch {
+	if m := r.lookupExact(name, filter); m != nil {
+		return m
+	}
+	if alias, ok := r.Normalizer.Alias(name); ok {
+		if m := r.lookupExact(alias, filter); m != nil {
+			m.Confidence = ConfidenceAlias
+			return m
+		}
+	}
+
+	key, confidence := r.Normalizer.Key(name)
+	if key == "" {
+		return nil
+	}
+	if m := r.lookupNormalized(key, confidence*ConfidenceNormalized, filter); m != nil {
+		return m
+	}
+	if key, ok := r.Normalizer.WithDefaultSuffix(key); ok {
+		return r.lookupNormalized(key, confidence*ConfidenceNormalized*ConfidenceDefaultSuffix, filter)
+	}
+	return nil
+}
+
+func (r *Registry) lookupExact(name string, filter func(*Book) bool) *NameMatch {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, book := range r.Books {
+		if !filter(book) {
+			continue
+		}
+		if color, ok := book.index[key]; ok {
+			return &NameMatch{Color: color, Book: book, Confidence: ConfidenceExact}
+		}
+	}
+	return nil
+}
+
+func (r *Registry) lookupNormalized(key string, confidence float64, filter func(*Book) bool) *NameMatch {
+	for _, entry := range r.normIndex[key] {
+		if filter(entry.book) {
+			return &NameMatch{Color: entry.color, Book: entry.book, Confidence: confidence * entry.confidence}
+		}
+	}
+	return nil
+}
+
+// Nearest return up to n spot colors closest to the Lab color by CIEDE2000
+func (r *Registry) Nearest(lab []float64, n int) []Match {
+	var matches []Match
+	for _, book := range r.Books {
+		if book.Process {
+			continue
+		}
+		for _, m := range dzi.Nearest(lab, book.labs, n) {
+			matches = append(matches, Match{
+				Color:   book.index[strings.ToLower(m.Name)],
+				Library: book.Title,
+				DeltaE:  m.DeltaE,
+			})
+		}
 	}
-	Pantones = make(map[string][]int)
-	for _, color := range j.Colors {
-		Pantones[strings.ToLower(color.Name)] = dzi.Lab2rgb(color.LabComponents)
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		return cmp.Compare(a.DeltaE, b.DeltaE)
+	})
+	if n < len(matches) {
+		matches = matches[:n]
 	}
+	return matches
 }
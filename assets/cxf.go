@@ -0,0 +1,248 @@
+package assets
+
+import (
+	"encoding/xml"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+
+	dzi "github.com/brandquad/dzi/colorutils"
+)
+
+type cxfDocument struct {
+	Description string             `xml:"FileInformation>Description"`
+	Objects     []cxfObject        `xml:"Resources>ObjectCollection>Object"`
+	Specs       []cxfSpecification `xml:"Resources>ColorSpecificationCollection>ColorSpecification"`
+}
+
+type cxfObject struct {
+	Name     string        `xml:"Name,attr"`
+	Lab      *cxfLab       `xml:"ColorValues>ColorCIELab"`
+	SRGB     *cxfRGB       `xml:"ColorValues>ColorSRGB"`
+	CMYK     *cxfCMYK      `xml:"ColorValues>ColorCMYK"`
+	Spectrum []cxfSpectrum `xml:"ColorValues>ReflectanceSpectrum"`
+}
+
+// cxfSpectrum is reflectance factors 0-1 separated by spaces
+type cxfSpectrum struct {
+	ColorSpecification string  `xml:"ColorSpecification,attr"`
+	StartWL            float64 `xml:"StartWL,attr"`
+	Values             string  `xml:",chardata"`
+}
+
+type cxfSpecification struct {
+	Id    string             `xml:"Id,attr"`
+	Range cxfWavelengthRange `xml:"MeasurementSpec>WavelengthRange"`
+}
+
+type cxfWavelengthRange struct {
+	StartWL   float64 `xml:"StartWL,attr"`
+	Increment float64 `xml:"Increment,attr"`
+}
+
+type cxfLab struct {
+	L float64 `xml:"L"`
+	A float64 `xml:"A"`
+	B float64 `xml:"B"`
+}
+
+type cxfRGB struct {
+	R float64 `xml:"R"`
+	G float64 `xml:"G"`
+	B float64 `xml:"B"`
+}
+
+type cxfCMYK struct {
+	Cyan    float64 `xml:"Cyan"`
+	Magenta float64 `xml:"Magenta"`
+	Yellow  float64 `xml:"Yellow"`
+	Black   float64 `xml:"Black"`
+}
+
+// ParseCxF read CxF3 (CxF/X-4) file, color is taken from CIELab, then reflectance spectrum converted
+// to Lab (D50, 2°), then sRGB, then CMYK values. Objects without usable values are skipped with a log message.
+func ParseCxF(buffer []byte, title string) (*Book, error) {
+	var doc cxfDocument
+	if err := xml.Unmarshal(buffer, &doc); err != nil {
+		return nil, err
+	}
+	if d := strings.TrimSpace(doc.Description); d != "" {
+		title = d
+	}
+
+	book := &Book{Title: title, ColorModel: ColorModelLab}
+	for _, object := range doc.Objects {
+		color := &BookColor{Name: strings.TrimSpace(object.Name)}
+		var spectrum []float64
+		var err error
+		if object.Lab == nil && len(object.Spectrum) > 0 {
+			if spectrum, err = doc.spectrumLab(object.Spectrum[0]); err != nil {
+				log.Printf("[!] CxF %s: skip %q, spectrum: %v", title, color.Name, err)
+				continue
+			}
+		}
+		switch {
+		case object.Lab != nil:
+			color.ColorModel = ColorModelLab
+			color.Components = []float64{object.Lab.L, object.Lab.A, object.Lab.B}
+		case spectrum != nil:
+			color.ColorModel = ColorModelLab
+			color.Components = spectrum
+		case object.SRGB != nil:
+			color.ColorModel = ColorModelRGB
+			color.Components = []float64{object.SRGB.R, object.SRGB.G, object.SRGB.B}
+		case object.CMYK != nil:
+			color.ColorModel = ColorModelCMYK
+			color.Components = []float64{object.CMYK.Cyan, object.CMYK.Magenta, object.CMYK.Yellow, object.CMYK.Black}
+		default:
+			log.Printf("[!] CxF %s: skip %q, no color values", title, color.Name)
+			continue
+		}
+		if color.Name == "" {
+			log.Printf("[!] CxF %s: skip color without name", title)
+			continue
+		}
+		if object.CMYK != nil {
+			color.CMYK = []float64{object.CMYK.Cyan, object.CMYK.Magenta, object.CMYK.Yellow, object.CMYK.Black}
+		}
+		book.Colors = append(book.Colors, color)
+	}
+
+	if err := book.prepare(); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+// spectrumLab convert reflectance spectrum to Lab, wavelength range is taken from the color specification
+// of the spectrum, 10 nm increment is used when it is not set
+func (doc *cxfDocument) spectrumLab(spectrum cxfSpectrum) ([]float64, error) {
+	startWL, increment := spectrum.StartWL, 10.0
+	for _, spec := range doc.Specs {
+		if spec.Id != spectrum.ColorSpecification {
+			continue
+		}
+		if startWL == 0 {
+			startWL = spec.Range.StartWL
+		}
+		if spec.Range.Increment > 0 {
+			increment = spec.Range.Increment
+		}
+	}
+	if startWL == 0 {
+		startWL = 380
+	}
+
+	fields := strings.Fields(spectrum.Values)
+	values := make([]float64, 0, len(fields))
+	for _, field := range fields {
+		value, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return dzi.Spectrum2lab(values, startWL, increment)
+}
+
+type cxfOutDocument struct {
+	XMLName     xml.Name       `xml:"CxF"`
+	Xmlns       string         `xml:"xmlns,attr"`
+	Creator     string         `xml:"FileInformation>Creator"`
+	Date        string         `xml:"FileInformation>CreationDate"`
+	Description string         `xml:"FileInformation>Description"`
+	Objects     []cxfOutObject `xml:"Resources>ObjectCollection>Object"`
+	Specs       []cxfOutSpec   `xml:"Resources>ColorSpecificationCollection>ColorSpecification"`
+}
+
+type cxfOutObject struct {
+	ObjectType string      `xml:"ObjectType,attr"`
+	Name       string      `xml:"Name,attr"`
+	Id         string      `xml:"Id,attr"`
+	Lab        *cxfOutLab  `xml:"ColorValues>ColorCIELab,omitempty"`
+	CMYK       *cxfOutCMYK `xml:"ColorValues>ColorCMYK,omitempty"`
+	SRGB       *cxfOutRGB  `xml:"ColorValues>ColorSRGB,omitempty"`
+}
+
+type cxfOutLab struct {
+	ColorSpecification string `xml:"ColorSpecification,attr"`
+	cxfLab
+}
+
+type cxfOutCMYK struct {
+	ColorSpecification string `xml:"ColorSpecification,attr"`
+	cxfCMYK
+}
+
+type cxfOutRGB struct {
+	ColorSpecification string `xml:"ColorSpecification,attr"`
+	cxfRGB
+}
+
+type cxfOutSpec struct {
+	Id          string `xml:"Id,attr"`
+	Illuminant  string `xml:"TristimulusSpec>Illuminant,omitempty"`
+	Observer    string `xml:"TristimulusSpec>Observer,omitempty"`
+	Method      string `xml:"TristimulusSpec>Method,omitempty"`
+	Measurement string `xml:"MeasurementSpec>MeasurementType"`
+}
+
+const (
+	cxfNamespace = "http://colorexchangeformat.com/CxF3-core"
+	cxfSpecLab   = "CIELab"
+	cxfSpecCMYK  = "CMYK"
+	cxfSpecSRGB  = "sRGB"
+)
+
+// EncodeCxF write book as CxF3 document with Lab, CMYK alternate (when known) and sRGB values of each color
+func EncodeCxF(book *Book, creator, date string) ([]byte, error) {
+	doc := cxfOutDocument{
+		Xmlns:       cxfNamespace,
+		Creator:     creator,
+		Date:        date,
+		Description: book.Title,
+		Specs: []cxfOutSpec{
+			{Id: cxfSpecLab, Illuminant: "D50", Observer: "2_Degree", Method: "Colorimetric", Measurement: "Colorimetric_Reflectance"},
+			{Id: cxfSpecCMYK, Measurement: "Colorant_Percentage"},
+			{Id: cxfSpecSRGB, Measurement: "RGB_Emissive"},
+		},
+	}
+	for idx, color := range book.Colors {
+		object := cxfOutObject{
+			ObjectType: "Standard",
+			Name:       color.Name,
+			Id:         strconv.Itoa(idx + 1),
+			Lab: &cxfOutLab{
+				ColorSpecification: cxfSpecLab,
+				cxfLab:             cxfLab{L: round2(color.Lab[0]), A: round2(color.Lab[1]), B: round2(color.Lab[2])},
+			},
+			SRGB: &cxfOutRGB{
+				ColorSpecification: cxfSpecSRGB,
+				cxfRGB:             cxfRGB{R: float64(color.RGB[0]), G: float64(color.RGB[1]), B: float64(color.RGB[2])},
+			},
+		}
+		if len(color.CMYK) == 4 {
+			object.CMYK = &cxfOutCMYK{
+				ColorSpecification: cxfSpecCMYK,
+				cxfCMYK: cxfCMYK{
+					Cyan:    round2(color.CMYK[0]),
+					Magenta: round2(color.CMYK[1]),
+					Yellow:  round2(color.CMYK[2]),
+					Black:   round2(color.CMYK[3]),
+				},
+			}
+		}
+		doc.Objects = append(doc.Objects, object)
+	}
+
+	buffer, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), buffer...), nil
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
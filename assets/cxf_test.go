@@ -0,0 +1,100 @@
+package assets
+
+import (
+	"math"
+	"testing"
+)
+
+const cxfSpectralSample = `<?xml version="1.0" encoding="UTF-8"?>
+<cc:CxF xmlns:cc="http://colorexchangeformat.com/CxF3-core">
+  <cc:FileInformation>
+    <cc:Description>Spectral</cc:Description>
+  </cc:FileInformation>
+  <cc:Resources>
+    <cc:ObjectCollection>
+      <cc:Object ObjectType="Standard" Name="Grey 18" Id="1">
+        <cc:ColorValues>
+          <cc:ReflectanceSpectrum ColorSpecification="M0_10" StartWL="380">0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18 0.18</cc:ReflectanceSpectrum>
+        </cc:ColorValues>
+      </cc:Object>
+      <cc:Object ObjectType="Standard" Name="White" Id="2">
+        <cc:ColorValues>
+          <cc:ReflectanceSpectrum ColorSpecification="M1_20">1 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1</cc:ReflectanceSpectrum>
+        </cc:ColorValues>
+      </cc:Object>
+      <cc:Object ObjectType="Standard" Name="Red" Id="3">
+        <cc:ColorValues>
+          <cc:ReflectanceSpectrum ColorSpecification="M1_20">0.05 0.05 0.05 0.05 0.05 0.05 0.05 0.05 0.05 0.05 0.8 0.8 0.8 0.8 0.8 0.8</cc:ReflectanceSpectrum>
+        </cc:ColorValues>
+      </cc:Object>
+      <cc:Object ObjectType="Standard" Name="Measured" Id="4">
+        <cc:ColorValues>
+          <cc:ColorCIELab ColorSpecification="Lab"><cc:L>60</cc:L><cc:A>10</cc:A><cc:B>-20</cc:B></cc:ColorCIELab>
+          <cc:ReflectanceSpectrum ColorSpecification="M1_20">0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5 0.5</cc:ReflectanceSpectrum>
+        </cc:ColorValues>
+      </cc:Object>
+      <cc:Object ObjectType="Standard" Name="Empty" Id="5">
+        <cc:ColorValues/>
+      </cc:Object>
+    </cc:ObjectCollection>
+    <cc:ColorSpecificationCollection>
+      <cc:ColorSpecification Id="M0_10">
+        <cc:MeasurementSpec>
+          <cc:WavelengthRange StartWL="380" Increment="10"/>
+        </cc:MeasurementSpec>
+      </cc:ColorSpecification>
+      <cc:ColorSpecification Id="M1_20">
+        <cc:MeasurementSpec>
+          <cc:WavelengthRange StartWL="400" Increment="20"/>
+        </cc:MeasurementSpec>
+      </cc:ColorSpecification>
+    </cc:ColorSpecificationCollection>
+  </cc:Resources>
+</cc:CxF>`
+
+func TestParseCxFSpectrum(t *testing.T) {
+	book, err := ParseCxF([]byte(cxfSpectralSample), "sample")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Title != "Spectral" {
+		t.Errorf("title %q, want Spectral", book.Title)
+	}
+
+	labs := make(map[string][]float64)
+	for _, color := range book.Colors {
+		labs[color.Name] = color.Lab
+	}
+	if _, ok := labs["Empty"]; ok {
+		t.Error("object without values is not skipped")
+	}
+
+	// Flat spectrum is neutral, L* of 18% reflectance is 116 * 0.18^(1/3) - 16.
+	// Measured Lab takes precedence over the spectrum.
+	tests := []struct {
+		name string
+		lab  []float64
+	}{
+		{"Grey 18", []float64{116*math.Cbrt(0.18) - 16, 0, 0}},
+		{"White", []float64{100, 0, 0}},
+		{"Measured", []float64{60, 10, -20}},
+	}
+	for _, tt := range tests {
+		lab, ok := labs[tt.name]
+		if !ok {
+			t.Errorf("%s: not parsed", tt.name)
+			continue
+		}
+		for i := range tt.lab {
+			if math.Abs(lab[i]-tt.lab[i]) > 1e-6 {
+				t.Errorf("%s: Lab %v, want %v", tt.name, lab, tt.lab)
+				break
+			}
+		}
+	}
+
+	// Reflectance from 600 nm only is a red with positive a* and b*
+	if lab := labs["Red"]; lab == nil || lab[1] < 30 || lab[2] < 10 {
+		t.Errorf("Red: Lab %v, want positive a* and b*", lab)
+	}
+}
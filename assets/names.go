@@ -0,0 +1,172 @@
+package assets
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Confidence of name matches, normalized matches are multiplied by confidence of each applied alias
+const (
+	ConfidenceExact         = 1.0
+	ConfidenceAlias         = 0.9
+	ConfidenceNormalized    = 0.95
+	ConfidencePrefixAlias   = 0.9
+	ConfidenceSuffixAlias   = 0.9
+	ConfidenceDefaultSuffix = 0.6
+)
+
+// AliasTables configure name normalization. Prefixes and suffixes map first and last token
+// of the normalized name, names map whole color name (case-insensitive) to the library name.
+type AliasTables struct {
+	Prefixes      map[string]string `json:"prefixes"`
+	Suffixes      map[string]string `json:"suffixes"`
+	Names         map[string]string `json:"names"`
+	DefaultSuffix string            `json:"default_suffix"`
+}
+
+var defaultAliasTables = AliasTables{
+	Prefixes: map[string]string{
+		"pms": "pantone",
+		"p":   "pantone",
+	},
+	Suffixes: map[string]string{
+		"cvc":      "c",
+		"cv":       "c",
+		"cp":       "c",
+		"coated":   "c",
+		"cvu":      "u",
+		"up":       "u",
+		"uncoated": "u",
+		"cvm":      "m",
+		"matte":    "m",
+	},
+	DefaultSuffix: "c",
+}
+
+// Normalizer build comparable keys of color names
+type Normalizer struct {
+	tables AliasTables
+	// libraries prefixes which use suffixes, like "pantone"
+	suffixed map[string]bool
+}
+
+func NewNormalizer(tables AliasTables) *Normalizer {
+	n := &Normalizer{tables: tables, suffixed: make(map[string]bool)}
+	for _, v := range tables.Prefixes {
+		n.suffixed[v] = true
+	}
+	return n
+}
+
+// DefaultNormalizer return normalizer with built-in alias tables
+func DefaultNormalizer() *Normalizer {
+	return NewNormalizer(defaultAliasTables)
+}
+
+// LoadNormalizer read alias tables JSON and merge it over built-in tables.
+// Empty filepath means built-in tables only.
+func LoadNormalizer(filepath string) (*Normalizer, error) {
+	tables := AliasTables{
+		Prefixes:      maps.Clone(defaultAliasTables.Prefixes),
+		Suffixes:      maps.Clone(defaultAliasTables.Suffixes),
+		Names:         make(map[string]string),
+		DefaultSuffix: defaultAliasTables.DefaultSuffix,
+	}
+	if filepath == "" {
+		return NewNormalizer(tables), nil
+	}
+
+	buffer, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	var custom AliasTables
+	if err = json.Unmarshal(buffer, &custom); err != nil {
+		return nil, err
+	}
+	for k, v := range custom.Prefixes {
+		tables.Prefixes[strings.ToLower(k)] = strings.ToLower(v)
+	}
+	for k, v := range custom.Suffixes {
+		tables.Suffixes[strings.ToLower(k)] = strings.ToLower(v)
+	}
+	for k, v := range custom.Names {
+		tables.Names[strings.ToLower(k)] = v
+	}
+	if custom.DefaultSuffix != "" {
+		tables.DefaultSuffix = strings.ToLower(custom.DefaultSuffix)
+	}
+	return NewNormalizer(tables), nil
+}
+
+// Alias return library name for the color name from names table
+func (n *Normalizer) Alias(name string) (string, bool) {
+	v, ok := n.tables.Names[strings.ToLower(strings.TrimSpace(name))]
+	return v, ok
+}
+
+// Tokens split name into lowercase tokens by spaces, punctuation and letter-digit boundaries,
+// so "PMS185C" and "pms 185 c" give the same tokens
+func Tokens(name string) []string {
+	var tokens []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, string(current))
+			current = current[:0]
+		}
+	}
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if len(current) > 0 && unicode.IsDigit(current[len(current)-1]) != unicode.IsDigit(r) {
+				flush()
+			}
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// Key return normalized name with prefix and suffix aliases applied and confidence of the normalization
+func (n *Normalizer) Key(name string) (string, float64) {
+	tokens := Tokens(name)
+	if len(tokens) == 0 {
+		return "", 0
+	}
+
+	confidence := 1.0
+	if v, ok := n.tables.Prefixes[tokens[0]]; ok && v != tokens[0] {
+		tokens[0] = v
+		confidence *= ConfidencePrefixAlias
+	}
+	if len(tokens) > 1 && n.suffixed[tokens[0]] {
+		last := len(tokens) - 1
+		if v, ok := n.tables.Suffixes[tokens[last]]; ok && v != tokens[last] {
+			tokens[last] = v
+			confidence *= ConfidenceSuffixAlias
+		}
+	}
+	return strings.Join(tokens, " "), confidence
+}
+
+// WithDefaultSuffix return key with default suffix for suffixed libraries names without suffix, like "pantone 185"
+func (n *Normalizer) WithDefaultSuffix(key string) (string, bool) {
+	tokens := strings.Split(key, " ")
+	if n.tables.DefaultSuffix == "" || len(tokens) < 2 || !n.suffixed[tokens[0]] {
+		return "", false
+	}
+	last := tokens[len(tokens)-1]
+	for _, v := range n.tables.Suffixes {
+		if v == last {
+			return "", false
+		}
+	}
+	return key + " " + n.tables.DefaultSuffix, true
+}
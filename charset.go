@@ -0,0 +1,115 @@
+package dzi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"log"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/saintfish/chardet"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// fallbackCharset used when charset is not detected, most of our non-UTF-8 documents are Cyrillic
+const fallbackCharset = "windows-1251"
+
+// minCharsetConfidence detected charsets with lower chardet confidence (0-100) are tried after hints
+// and fallback charset. Short spot names give chardet too little text, it reports CJK charsets
+// with confidence 10 for most of Cyrillic names.
+const minCharsetConfidence = 60
+
+// decodedName is a spot name with source bytes and detected charset
+type decodedName struct {
+	Name    string
+	Raw     string
+	Charset string
+}
+
+// decodeName decode spot name bytes. UTF-8 names are kept as is, one hint forces the charset,
+// several hints limit detected charsets to them. Without hints a confidently detected charset is used,
+// then fallback charset.
+func decodeName(raw []byte, hints []string) decodedName {
+	if utf8.Valid(raw) {
+		return decodedName{Name: string(raw)}
+	}
+	result := decodedName{Raw: hex.EncodeToString(raw)}
+
+	for _, charset := range nameCharsets(raw, hints) {
+		enc, err := charsetEncoding(charset)
+		if err != nil {
+			log.Printf("[!] Unknown charset %s: %v", charset, err)
+			continue
+		}
+		decoded, err := enc.NewDecoder().Bytes(raw)
+		if err != nil || !utf8.Valid(decoded) || bytes.ContainsRune(decoded, utf8.RuneError) {
+			continue
+		}
+		result.Name = string(decoded)
+		result.Charset, _ = htmlindex.Name(enc)
+		return result
+	}
+
+	result.Name = strings.ToValidUTF8(string(raw), "?")
+	return result
+}
+
+// nameCharsets return candidate charsets in order of preference
+func nameCharsets(raw []byte, hints []string) []string {
+	if len(hints) == 1 {
+		return hints
+	}
+
+	var detected, uncertain []string
+	if results, err := chardet.NewTextDetector().DetectAll(raw); err == nil {
+		for _, r := range results {
+			switch {
+			case r.Charset == "UTF-8":
+				continue
+			case r.Confidence < minCharsetConfidence:
+				uncertain = append(uncertain, r.Charset)
+			default:
+				detected = append(detected, r.Charset)
+			}
+		}
+	}
+
+	if len(hints) == 0 {
+		return append(append(detected, fallbackCharset), uncertain...)
+	}
+	var candidates []string
+	for _, charset := range detected {
+		if slices.ContainsFunc(hints, func(h string) bool { return sameCharset(h, charset) }) {
+			candidates = append(candidates, charset)
+		}
+	}
+	return append(candidates, hints...)
+}
+
+// chardetLabels chardet charset names which are not WHATWG labels
+var chardetLabels = map[string]string{
+	"GB-18030": "gb18030",
+}
+
+// charsetEncoding return encoding by WHATWG label or chardet charset name
+func charsetEncoding(charset string) (encoding.Encoding, error) {
+	if label, ok := chardetLabels[charset]; ok {
+		charset = label
+	}
+	return htmlindex.Get(charset)
+}
+
+// sameCharset compare charset labels by encoding, e.g. "cp1251" and "windows-1251"
+func sameCharset(a, b string) bool {
+	ea, err := charsetEncoding(a)
+	if err != nil {
+		return false
+	}
+	eb, err := charsetEncoding(b)
+	if err != nil {
+		return false
+	}
+	return ea == eb
+}
@@ -0,0 +1,45 @@
+package dzi
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestDecodeName(t *testing.T) {
+	tests := []struct {
+		name    string
+		enc     encoding.Encoding
+		hints   []string
+		charset string
+	}{
+		{name: "Белила", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "Лак ВД", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "Лак", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "Вырубка", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "Золото", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "Серебро металлик", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "Технологическая краска", enc: charmap.Windows1251, charset: "windows-1251"},
+		{name: "PANTONE 185 C", charset: ""},
+		{name: "Белила", charset: ""},
+		{name: "金色", enc: simplifiedchinese.GB18030, hints: []string{"gb18030"}, charset: "gb18030"},
+		{name: "特色インク", enc: japanese.ShiftJIS, hints: []string{"shift_jis", "windows-1251"}, charset: "shift_jis"},
+		{name: "Белила", enc: charmap.Windows1251, hints: []string{"cp1251", "shift_jis"}, charset: "windows-1251"},
+	}
+	for _, tt := range tests {
+		raw := []byte(tt.name)
+		if tt.enc != nil {
+			var err error
+			if raw, err = tt.enc.NewEncoder().Bytes(raw); err != nil {
+				t.Fatalf("encode %q: %v", tt.name, err)
+			}
+		}
+		got := decodeName(raw, tt.hints)
+		if got.Name != tt.name || got.Charset != tt.charset {
+			t.Errorf("decodeName(%q, %v) = %q (%s), want %q (%s)", tt.name, tt.hints, got.Name, got.Charset, tt.name, tt.charset)
+		}
+	}
+}
@@ -3,9 +3,11 @@ package main
 import (
 	"flag"
 	"log"
+	"net/url"
 	"os"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/brandquad/dzi"
 	"github.com/davidbyttow/govips/v2/vips"
@@ -13,37 +15,89 @@ import (
 )
 
 type Config struct {
-	S3Host             string  `envconfig:"DZI_S3_HOST" required:"true"`
-	S3Key              string  `envconfig:"DZI_S3_KEY" required:"true"`
-	S3Secret           string  `envconfig:"DZI_S3_SECRET" required:"true"`
-	S3Bucket           string  `envconfig:"DZI_BUCKET" required:"true" default:"dzi"`
-	TileSize           string  `envconfig:"DZI_TILE_SIZE" default:"1024"`
-	Overlap            string  `envconfig:"DZI_OVERLAP" default:"1"`
-	Resolution         int     `envconfig:"DZI_RESOLUTION" default:"600"`
-	MinResolution      int     `envconfig:"DZI_MIN_RESOLUTION" default:"200"`
-	MaxResolution      int     `envconfig:"DZI_MAX_RESOLUTION" default:"1600"`
-	CoverHeight        string  `envconfig:"DZI_COVER_H" default:"300"`
-	DebugMode          bool    `envconfig:"DZI_DEBUG" default:"false"`
-	SplitChannels      bool    `envconfig:"DZI_SPLIT_CHANNELS" default:"true"`
-	Overprint          string  `envconfig:"DZI_OVERPRINT" default:"/simulate"`
-	HookUrl            string  `envconfig:"HOOK_URL"`
-	CopyChannelsToS3   bool    `envconfig:"DZI_COPY_CHANNELS" default:"true"`
-	MaxCpuCount        int     `envconfig:"MAX_CPU_COUNT" default:"4"`
-	MaxSizePixels      float64 `envconfig:"MAX_SIZE_PIXELS" default:"15000"`
-	ExtractText        bool    `envconfig:"DZI_EXTRACT_TEXT" default:"true"`
-	TileFormat         string  `envconfig:"DZI_TILE_FORMAT" default:"png"`
-	TileSetting        string  `envconfig:"DZI_TILE_SETTING" default:""`
-	ICCProfileFilepath string  `envconfig:"ICC_PROFILE_PATH" default:"./icc/sRGB_Profile.icc"`
-	GraphicsAlphaBits  int     `envconfig:"GRAPHICS_ALPHA_BITS" default:"4"`
-	UsePDFX3           bool    `envconfig:"DZI_USE_PDFX3" default:"true"`
-	LibreOfficePath    string  `envconfig:"SOFFICE_PATH" default:"soffice"`
+	S3Host             string   `envconfig:"DZI_S3_HOST" required:"true"`
+	S3Key              string   `envconfig:"DZI_S3_KEY" required:"true"`
+	S3Secret           string   `envconfig:"DZI_S3_SECRET" required:"true"`
+	S3Bucket           string   `envconfig:"DZI_BUCKET" required:"true" default:"dzi"`
+	TileSize           string   `envconfig:"DZI_TILE_SIZE" default:"1024"`
+	Overlap            string   `envconfig:"DZI_OVERLAP" default:"1"`
+	Resolution         int      `envconfig:"DZI_RESOLUTION" default:"600"`
+	MinResolution      int      `envconfig:"DZI_MIN_RESOLUTION" default:"200"`
+	MaxResolution      int      `envconfig:"DZI_MAX_RESOLUTION" default:"1600"`
+	CoverHeight        string   `envconfig:"DZI_COVER_H" default:"300"`
+	DebugMode          bool     `envconfig:"DZI_DEBUG" default:"false"`
+	SplitChannels      bool     `envconfig:"DZI_SPLIT_CHANNELS" default:"true"`
+	Overprint          string   `envconfig:"DZI_OVERPRINT" default:"/simulate"`
+	OverprintModes     []string `envconfig:"DZI_OVERPRINT_MODES"`
+	HookUrl            string   `envconfig:"HOOK_URL"`
+	CopyChannelsToS3   bool     `envconfig:"DZI_COPY_CHANNELS" default:"true"`
+	MaxCpuCount        int      `envconfig:"MAX_CPU_COUNT" default:"4"`
+	VipsConcurrency    int      `envconfig:"VIPS_CONCURRENCY" default:"0"`
+	MaxSizePixels      float64  `envconfig:"MAX_SIZE_PIXELS" default:"15000"`
+	ExtractText        bool     `envconfig:"DZI_EXTRACT_TEXT" default:"true"`
+	TileFormat         string   `envconfig:"DZI_TILE_FORMAT" default:"png"`
+	TileSetting        string   `envconfig:"DZI_TILE_SETTING" default:""`
+	ICCProfileFilepath string   `envconfig:"ICC_PROFILE_PATH" default:"./icc/sRGB_Profile.icc"`
+	GraphicsAlphaBits  int      `envconfig:"GRAPHICS_ALPHA_BITS" default:"4"`
+	UsePDFX3           bool     `envconfig:"DZI_USE_PDFX3" default:"true"`
+	LibreOfficePath    string   `envconfig:"SOFFICE_PATH" default:"soffice"`
+	InkRulesFilepath   string   `envconfig:"DZI_INK_RULES_PATH"`
+	ColorBooksPath     string   `envconfig:"DZI_COLOR_BOOKS_PATH"`
+	NameAliasesPath    string   `envconfig:"DZI_NAME_ALIASES_PATH"`
+	CharsetHints       []string `envconfig:"DZI_CHARSET_HINTS"`
+	SwatchLibraries    []string `envconfig:"DZI_SWATCH_LIBRARIES"`
+	ExcludeInkRoles    []string `envconfig:"DZI_COMPOSITE_EXCLUDE_ROLES"`
+	SubstrateColor     string   `envconfig:"DZI_SUBSTRATE_COLOR"`
+	SubstrateTexture   string   `envconfig:"DZI_SUBSTRATE_TEXTURE"`
+	InkCoverage        bool     `envconfig:"DZI_INK_COVERAGE" default:"false"`
+	TotalAreaCoverage  bool     `envconfig:"DZI_TAC" default:"false"`
+	InkLimit           float64  `envconfig:"DZI_INK_LIMIT" default:"300"`
+	RenderLayers       bool     `envconfig:"DZI_RENDER_LAYERS" default:"false"`
+	LayerCombinations  string   `envconfig:"DZI_LAYER_COMBINATIONS"`
+	AlphaChannels      bool     `envconfig:"DZI_ALPHA_CHANNELS" default:"false"`
+	ProofProfilesPath  string   `envconfig:"DZI_PROOF_PROFILES_PATH"`
+	ProofConditions    []string `envconfig:"DZI_PROOF_CONDITIONS"`
+	CVDSimulations     []string `envconfig:"DZI_CVD_SIMULATIONS"`
+	Screening          bool     `envconfig:"DZI_SCREENING" default:"false"`
+	ScreenRuling       float64  `envconfig:"DZI_SCREEN_RULING" default:"150"`
+	ScreenDotShape     string   `envconfig:"DZI_SCREEN_DOT_SHAPE" default:"round"`
+	ScreenSettings     string   `envconfig:"DZI_SCREEN_SETTINGS"`
+	IIIF               bool     `envconfig:"DZI_IIIF" default:"false"`
+	IIIFBaseURL        string   `envconfig:"DZI_IIIF_BASE_URL"`
 	//SendToAnalyzer     bool    `envconfig:"SEND_TO_ANALYZER" default:"false"`
 }
 
 func (c Config) MakeDziConfig() *dzi.Config {
-	if !slices.Contains([]string{dzi.OverprintEnabled, dzi.OverprintSimulate, dzi.OverprintDisable}, c.Overprint) {
+	overprintModes := []string{dzi.OverprintEnabled, dzi.OverprintSimulate, dzi.OverprintDisable}
+	if !slices.Contains(overprintModes, c.Overprint) {
 		log.Fatalln("overprint not correct")
 	}
+	for _, mode := range c.OverprintModes {
+		if !slices.Contains(overprintModes, mode) {
+			log.Fatalln("overprint mode not correct:", mode)
+		}
+	}
+
+	excludeInkRoles := make([]dzi.InkRole, 0, len(c.ExcludeInkRoles))
+	for _, role := range c.ExcludeInkRoles {
+		excludeInkRoles = append(excludeInkRoles, dzi.InkRole(strings.TrimSpace(role)))
+	}
+
+	if c.IIIF {
+		if u, err := url.Parse(c.IIIFBaseURL); err != nil || !u.IsAbs() || u.Host == "" {
+			log.Fatalln("DZI_IIIF_BASE_URL must be an absolute URL when DZI_IIIF is enabled")
+		}
+	}
+
+	cvdModes := []string{dzi.CVDProtanopia, dzi.CVDDeuteranopia, dzi.CVDTritanopia}
+	cvdSimulations := make([]string, 0, len(c.CVDSimulations))
+	for _, simulation := range c.CVDSimulations {
+		simulation = strings.ToLower(strings.TrimSpace(simulation))
+		if !slices.Contains(cvdModes, simulation) {
+			log.Fatalln("CVD simulation not correct:", simulation)
+		}
+		cvdSimulations = append(cvdSimulations, simulation)
+	}
 
 	return &dzi.Config{
 		S3Host:             c.S3Host,
@@ -59,21 +113,120 @@ func (c Config) MakeDziConfig() *dzi.Config {
 		DebugMode:          c.DebugMode,
 		CopyChannelsToS3:   c.CopyChannelsToS3,
 		Overprint:          c.Overprint,
+		OverprintModes:     c.OverprintModes,
 		DefaultDPI:         float64(c.Resolution),
 		MinResolution:      c.MinResolution,
 		MaxResolution:      c.MaxResolution,
 		MaxSizePixels:      c.MaxSizePixels,
 		MaxCpuCount:        c.MaxCpuCount,
+		VipsConcurrency:    c.VipsConcurrency,
 		ExtractText:        c.ExtractText,
 		TileFormat:         c.TileFormat,
 		TileSetting:        c.TileSetting,
 		GraphicsAlphaBits:  c.GraphicsAlphaBits,
 		UsePDFX3:           c.UsePDFX3,
 		LibreOfficePath:    c.LibreOfficePath,
+		InkRulesFilepath:   c.InkRulesFilepath,
+		ColorBooksPath:     c.ColorBooksPath,
+		NameAliasesPath:    c.NameAliasesPath,
+		CharsetHints:       c.CharsetHints,
+		SwatchLibraries:    c.SwatchLibraries,
+		ExcludeInkRoles:    excludeInkRoles,
+		SubstrateColor:     c.SubstrateColor,
+		SubstrateTexture:   c.SubstrateTexture,
+		InkCoverage:        c.InkCoverage,
+		TotalAreaCoverage:  c.TotalAreaCoverage,
+		InkLimit:           c.InkLimit,
+		RenderLayers:       c.RenderLayers,
+		LayerCombinations:  parseLayerCombinations(c.LayerCombinations),
+		AlphaChannels:      c.AlphaChannels,
+		ProofProfilesPath:  c.ProofProfilesPath,
+		ProofConditions:    c.ProofConditions,
+		CVDSimulations:     cvdSimulations,
+		Screening:          c.Screening,
+		ScreenRuling:       c.ScreenRuling,
+		ScreenDotShape:     c.ScreenDotShape,
+		ScreenSettings:     parseScreenSettings(c.ScreenSettings),
+		IIIF:               c.IIIF,
+		IIIFBaseURL:        c.IIIFBaseURL,
 		//SendToAnalyzer:     c.SendToAnalyzer,
 	}
 }
 
+// parseLayerCombinations parse combinations in form "Name=+Layer A,-Layer B;Other=+Layer C"
+func parseLayerCombinations(s string) []dzi.LayerCombination {
+	combinations := make([]dzi.LayerCombination, 0)
+	for _, item := range strings.Split(s, ";") {
+		name, layers, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		combination := dzi.LayerCombination{Name: strings.TrimSpace(name)}
+		for _, layer := range strings.Split(layers, ",") {
+			layer = strings.TrimSpace(layer)
+			switch {
+			case strings.HasPrefix(layer, "+"):
+				combination.On = append(combination.On, strings.TrimSpace(layer[1:]))
+			case strings.HasPrefix(layer, "-"):
+				combination.Off = append(combination.Off, strings.TrimSpace(layer[1:]))
+			case layer != "":
+				combination.On = append(combination.On, layer)
+			}
+		}
+		combinations = append(combinations, combination)
+	}
+	return combinations
+}
+
+// parseScreenSettings parse screens in form "Cyan=15/150/round;PANTONE 185 C=45//square",
+// empty parts keep values from Esko metadata or defaults
+func parseScreenSettings(s string) []dzi.ScreenSetting {
+	settings := make([]dzi.ScreenSetting, 0)
+	for _, item := range strings.Split(s, ";") {
+		ink, values, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		setting := dzi.ScreenSetting{Ink: strings.TrimSpace(ink)}
+		parts := strings.Split(values, "/")
+		for idx, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			switch idx {
+			case 0, 1:
+				v, err := strconv.ParseFloat(part, 64)
+				if err != nil {
+					log.Fatalln("screen setting not correct:", item)
+				}
+				if idx == 0 {
+					setting.Angle = &v
+				} else {
+					setting.Ruling = &v
+				}
+			case 2:
+				setting.DotShape = part
+			}
+		}
+		settings = append(settings, setting)
+	}
+	return settings
+}
+
+// parseSwatchColors parse colors in form "PANTONE 185 C=#e4002b;Cyan=#00a0e0"
+func parseSwatchColors(s string) map[string]string {
+	colors := make(map[string]string)
+	for _, item := range strings.Split(s, ";") {
+		name, color, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		colors[strings.TrimSpace(name)] = strings.TrimSpace(color)
+	}
+	return colors
+}
+
 func main() {
 
 	var c Config
@@ -83,13 +236,52 @@ func main() {
 	//c.DebugMode = true
 	//c.ICCProfileFilepath = "./icc/sRGB_Profile.icc"
 
+	recolor := flag.String("recolor", "", `new swatch colors of the processed asset, "PANTONE 185 C=#e4002b;Cyan=#00a0e0"`)
+	retile := flag.Bool("retile", false, "rebuild DZI of processed assets with current tile settings, arguments are asset ids")
+	flag.Parse()
+
+	config := c.MakeDziConfig()
+	_, config.DebugMode = os.LookupEnv("DEBUG")
+
 	vips.LoggingSettings(func(messageDomain string, verbosity vips.LogLevel, message string) {}, vips.LogLevelInfo)
 	vips.Startup(&vips.Config{
-		ConcurrencyLevel: c.MaxCpuCount,
+		ConcurrencyLevel: dzi.VipsConcurrency(config),
 	})
 	defer vips.Shutdown()
 
-	flag.Parse()
+	if *retile {
+		if flag.NArg() < 1 {
+			log.Fatalln("AssetIds are required as arguments")
+		}
+		assetIds := make([]int, 0, flag.NArg())
+		for _, arg := range flag.Args() {
+			assetId, err := strconv.Atoi(arg)
+			if err != nil {
+				log.Fatalf("Failed to convert AssetId to integer: %v\n", err)
+			}
+			assetIds = append(assetIds, assetId)
+		}
+		if err := dzi.RetileAssets(assetIds, config); err != nil {
+			log.Fatalln(err)
+		}
+		return
+	}
+
+	if *recolor != "" {
+		if flag.NArg() < 1 {
+			log.Fatalln("AssetId is required as argument")
+		}
+		assetId, err := strconv.Atoi(flag.Arg(0))
+		if err != nil {
+			log.Fatalf("Failed to convert AssetId to integer: %v\n", err)
+		}
+		manifest, err := dzi.Recolorize(assetId, parseSwatchColors(*recolor), config)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		log.Println(manifest)
+		return
+	}
 
 	if flag.NArg() < 2 {
 		log.Fatalln("URL and AssetId are required as arguments")
@@ -101,8 +293,6 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to convert AssetId to integer: %v\n", err)
 	}
-	config := c.MakeDziConfig()
-	_, config.DebugMode = os.LookupEnv("DEBUG")
 	manifest, err := dzi.Processing(url, assetId, config)
 
 	if err != nil {
@@ -1,3472 +0,0 @@
-package dzi
-
-var CMYK = map[string]string{
-	"cyan":                    "#00a3d3",
-	"magenta":                 "#e00986",
-	"yellow":                  "#fbe016",
-	"blue":                    "#0000ff",
-	"green":                   "#00ff00",
-	"red":                     "#ff0000",
-	"white":                   "#ffffff",
-	"black":                   "#131413",
-	"alpha":                   "#ffffff",
-	"pantone 100 c":           "#f7ea5f",
-	"pantone 100 u":           "#fef27e",
-	"pantone 101 c":           "#f8e946",
-	"pantone 101 u":           "#ffef65",
-	"pantone 102 c":           "#fde300",
-	"pantone 102 u":           "#ffec28",
-	"pantone 103 c":           "#c6a900",
-	"pantone 103 u":           "#b59e28",
-	"pantone 104 c":           "#b19700",
-	"pantone 104 u":           "#9b8c37",
-	"pantone 105 c":           "#8b7a27",
-	"pantone 105 u":           "#7d7446",
-	"pantone 106 c":           "#fbe343",
-	"pantone 106 u":           "#ffea65",
-	"pantone 107 c":           "#fee01e",
-	"pantone 107 u":           "#ffe450",
-	"pantone 108 c":           "#ffdb00",
-	"pantone 108 u":           "#ffdc32",
-	"pantone 109 c":           "#ffd100",
-	"pantone 109 u":           "#ffc700",
-	"pantone 110 c":           "#dba900",
-	"pantone 110 u":           "#cd9f24",
-	"pantone 111 c":           "#ac8900",
-	"pantone 111 u":           "#9b8638",
-	"pantone 112 c":           "#9d830f",
-	"pantone 112 u":           "#8a7a3e",
-	"pantone 113 c":           "#fddf50",
-	"pantone 113 u":           "#ffe05e",
-	"pantone 114 c":           "#fedd3c",
-	"pantone 114 u":           "#ffd847",
-	"pantone 115 c":           "#ffd923",
-	"pantone 115 u":           "#ffcd29",
-	"pantone 116 c":           "#ffcd00",
-	"pantone 116 u":           "#ffb408",
-	"pantone 117 c":           "#cc9700",
-	"pantone 117 u":           "#b78b2e",
-	"pantone 118 c":           "#ae8400",
-	"pantone 118 u":           "#9e8038",
-	"pantone 119 c":           "#8a731f",
-	"pantone 119 u":           "#847647",
-	"pantone 120 c":           "#feda63",
-	"pantone 120 u":           "#ffd968",
-	"pantone 1205 c":          "#f9df8d",
-	"pantone 1205 u":          "#ffe394",
-	"pantone 121 c":           "#ffd755",
-	"pantone 121 u":           "#ffcb50",
-	"pantone 1215 c":          "#fed671",
-	"pantone 1215 u":          "#ffd97c",
-	"pantone 122 c":           "#ffd03e",
-	"pantone 122 u":           "#ffc03d",
-	"pantone 1225 c":          "#ffc842",
-	"pantone 1225 u":          "#ffba4d",
-	"pantone 123 c":           "#ffc627",
-	"pantone 123 u":           "#ffac25",
-	"pantone 1235 c":          "#ffb718",
-	"pantone 1235 u":          "#ffad38",
-	"pantone 124 c":           "#eda900",
-	"pantone 124 u":           "#d99132",
-	"pantone 1245 c":          "#c9910d",
-	"pantone 1245 u":          "#bb8a3f",
-	"pantone 125 c":           "#b78400",
-	"pantone 125 u":           "#a6803a",
-	"pantone 1255 c":          "#af841d",
-	"pantone 1255 u":          "#a18045",
-	"pantone 126 c":           "#9d760c",
-	"pantone 126 u":           "#8e7641",
-	"pantone 1265 c":          "#8a6b24",
-	"pantone 1265 u":          "#887447",
-	"pantone 127 c":           "#f5db6b",
-	"pantone 127 u":           "#fce182",
-	"pantone 128 c":           "#f7d44b",
-	"pantone 128 u":           "#fecd60",
-	"pantone 129 c":           "#f6ce3c",
-	"pantone 129 u":           "#fead3c",
-	"pantone 130 c":           "#f7a700",
-	"pantone 130 u":           "#fc992e",
-	"pantone 131 c":           "#d08900",
-	"pantone 131 u":           "#be7e33",
-	"pantone 132 c":           "#a37300",
-	"pantone 132 u":           "#99793d",
-	"pantone 133 c":           "#6e561b",
-	"pantone 133 u":           "#7c6f46",
-	"pantone 134 c":           "#ffd16c",
-	"pantone 134 u":           "#ffd276",
-	"pantone 1345 c":          "#fecf84",
-	"pantone 1345 u":          "#ffd490",
-	"pantone 135 c":           "#ffc556",
-	"pantone 135 u":           "#ffc265",
-	"pantone 1355 c":          "#ffc56c",
-	"pantone 1355 u":          "#ffc87e",
-	"pantone 136 c":           "#ffbf3b",
-	"pantone 136 u":           "#ffac48",
-	"pantone 1365 c":          "#ffb546",
-	"pantone 1365 u":          "#ffac5a",
-	"pantone 137 c":           "#ffa300",
-	"pantone 137 u":           "#ffa035",
-	"pantone 1375 c":          "#ff9e15",
-	"pantone 1375 u":          "#ff993e",
-	"pantone 138 c":           "#e17c00",
-	"pantone 138 u":           "#d57b2f",
-	"pantone 1385 c":          "#d87800",
-	"pantone 1385 u":          "#cf7d3d",
-	"pantone 139 c":           "#b26d00",
-	"pantone 139 u":           "#9e6e3c",
-	"pantone 1395 c":          "#9c5f15",
-	"pantone 1395 u":          "#956d42",
-	"pantone 140 c":           "#755319",
-	"pantone 140 u":           "#806944",
-	"pantone 1405 c":          "#704b1c",
-	"pantone 1405 u":          "#7a6446",
-	"pantone 141 c":           "#f6c55b",
-	"pantone 141 u":           "#fbbf6d",
-	"pantone 142 c":           "#f5bd47",
-	"pantone 142 u":           "#fbae5c",
-	"pantone 143 c":           "#f6b333",
-	"pantone 143 u":           "#fb9e4e",
-	"pantone 144 c":           "#f38a00",
-	"pantone 144 u":           "#f78a3b",
-	"pantone 145 c":           "#d57e00",
-	"pantone 145 u":           "#cc7d38",
-	"pantone 146 c":           "#ab6b0e",
-	"pantone 146 u":           "#9e7340",
-	"pantone 147 c":           "#735b28",
-	"pantone 147 u":           "#776849",
-	"pantone 148 c":           "#ffcb8a",
-	"pantone 148 u":           "#ffc586",
-	"pantone 1485 c":          "#ffad5e",
-	"pantone 1485 u":          "#ffa660",
-	"pantone 149 c":           "#ffc27a",
-	"pantone 149 u":           "#ffb573",
-	"pantone 1495 c":          "#ff8f12",
-	"pantone 1495 u":          "#ff934e",
-	"pantone 150 c":           "#ffb258",
-	"pantone 150 u":           "#ff9954",
-	"pantone 1505 c":          "#ff6a00",
-	"pantone 1505 u":          "#ff8135",
-	"pantone 151 c":           "#ff8300",
-	"pantone 151 u":           "#ff873b",
-	"pantone 152 c":           "#e97200",
-	"pantone 152 u":           "#e3793d",
-	"pantone 1525 c":          "#bd4600",
-	"pantone 1525 u":          "#be6437",
-	"pantone 153 c":           "#c16a10",
-	"pantone 153 u":           "#ad6e41",
-	"pantone 1535 c":          "#974508",
-	"pantone 1535 u":          "#976240",
-	"pantone 154 c":           "#9e5916",
-	"pantone 154 u":           "#926743",
-	"pantone 1545 c":          "#683816",
-	"pantone 1545 u":          "#7b5e48",
-	"pantone 155 c":           "#f3d09e",
-	"pantone 155 u":           "#fac895",
-	"pantone 1555 c":          "#ffb98d",
-	"pantone 1555 u":          "#ffc29c",
-	"pantone 156 c":           "#f2bc7b",
-	"pantone 156 u":           "#fbb883",
-	"pantone 1565 c":          "#ffa167",
-	"pantone 1565 u":          "#ffaa81",
-	"pantone 157 c":           "#f19f53",
-	"pantone 157 u":           "#f69462",
-	"pantone 1575 c":          "#ff7e2e",
-	"pantone 1575 u":          "#ff9562",
-	"pantone 158 c":           "#ef7521",
-	"pantone 158 u":           "#f37d4a",
-	"pantone 1585 c":          "#ff6b0b",
-	"pantone 1585 u":          "#ff844e",
-	"pantone 159 c":           "#d25d12",
-	"pantone 159 u":           "#cd6f46",
-	"pantone 1595 c":          "#dc5f13",
-	"pantone 1595 u":          "#d77045",
-	"pantone 160 c":           "#a5541a",
-	"pantone 160 u":           "#a06a45",
-	"pantone 1605 c":          "#a95521",
-	"pantone 1605 u":          "#a86b4b",
-	"pantone 161 c":           "#633c20",
-	"pantone 161 u":           "#776149",
-	"pantone 1615 c":          "#8e471e",
-	"pantone 1615 u":          "#946449",
-	"pantone 162 c":           "#ffbe9e",
-	"pantone 162 u":           "#ffba98",
-	"pantone 1625 c":          "#ffa388",
-	"pantone 1625 u":          "#ffa88f",
-	"pantone 163 c":           "#ff9d6b",
-	"pantone 163 u":           "#ff9b75",
-	"pantone 1635 c":          "#ff8d6a",
-	"pantone 1635 u":          "#ff967c",
-	"pantone 164 c":           "#ff7f3f",
-	"pantone 164 u":           "#ff855d",
-	"pantone 1645 c":          "#ff6b35",
-	"pantone 1645 u":          "#ff7f60",
-	"pantone 165 c":           "#ff661b",
-	"pantone 165 u":           "#ff7546",
-	"pantone 1655 c":          "#ff4c00",
-	"pantone 1655 u":          "#ff704b",
-	"pantone 166 c":           "#e75200",
-	"pantone 166 u":           "#e5653e",
-	"pantone 1665 c":          "#e24301",
-	"pantone 1665 u":          "#e96847",
-	"pantone 167 c":           "#c25219",
-	"pantone 167 u":           "#b76546",
-	"pantone 1675 c":          "#ad431c",
-	"pantone 1675 u":          "#ac6149",
-	"pantone 168 c":           "#75381b",
-	"pantone 168 u":           "#8b614b",
-	"pantone 1685 c":          "#86391f",
-	"pantone 1685 u":          "#96604d",
-	"pantone 169 c":           "#ffb4aa",
-	"pantone 169 u":           "#ffb7ac",
-	"pantone 170 c":           "#ff8671",
-	"pantone 170 u":           "#ff8d7c",
-	"pantone 171 c":           "#ff5b34",
-	"pantone 171 u":           "#ff7762",
-	"pantone 172 c":           "#ff4611",
-	"pantone 172 u":           "#ff674a",
-	"pantone 173 c":           "#d3441c",
-	"pantone 173 u":           "#d06149",
-	"pantone 174 c":           "#99381f",
-	"pantone 174 u":           "#9d5b49",
-	"pantone 175 c":           "#6d3527",
-	"pantone 175 u":           "#7e584c",
-	"pantone 176 c":           "#ffb1b8",
-	"pantone 176 u":           "#ffb1b8",
-	"pantone 1765 c":          "#ffa4b3",
-	"pantone 1765 u":          "#ffa7b4",
-	"pantone 1767 c":          "#ffafbf",
-	"pantone 1767 u":          "#ffbac8",
-	"pantone 177 c":           "#ff8188",
-	"pantone 177 u":           "#ff8c8f",
-	"pantone 1775 c":          "#ff8e9e",
-	"pantone 1775 u":          "#ff8b97",
-	"pantone 1777 c":          "#ff637d",
-	"pantone 1777 u":          "#ff808f",
-	"pantone 178 c":           "#ff5859",
-	"pantone 178 u":           "#ff7675",
-	"pantone 1785 c":          "#ff485b",
-	"pantone 1785 u":          "#ff6570",
-	"pantone 1787 c":          "#fb3448",
-	"pantone 1787 u":          "#ff636f",
-	"pantone 1788 c":          "#f42434",
-	"pantone 1788 u":          "#fe5c61",
-	"pantone 179 c":           "#e53c2e",
-	"pantone 179 u":           "#e55f53",
-	"pantone 1795 c":          "#d8262e",
-	"pantone 1795 u":          "#dd5958",
-	"pantone 1797 c":          "#d03238",
-	"pantone 1797 u":          "#ce4e51",
-	"pantone 180 c":           "#c33a32",
-	"pantone 180 u":           "#c06058",
-	"pantone 1805 c":          "#b3272d",
-	"pantone 1805 u":          "#b65957",
-	"pantone 1807 c":          "#a83338",
-	"pantone 1807 u":          "#a95051",
-	"pantone 181 c":           "#84322c",
-	"pantone 181 u":           "#8b5951",
-	"pantone 1815 c":          "#802528",
-	"pantone 1815 u":          "#905651",
-	"pantone 1817 c":          "#663233",
-	"pantone 1817 u":          "#7a5352",
-	"pantone 182 c":           "#febbca",
-	"pantone 182 u":           "#ffb8c8",
-	"pantone 183 c":           "#ff9bb1",
-	"pantone 183 u":           "#ff8da2",
-	"pantone 184 c":           "#fc5272",
-	"pantone 184 u":           "#ff6d82",
-	"pantone 185 c":           "#eb0028",
-	"pantone 185 u":           "#f7505f",
-	"pantone 186 c":           "#cf0a2c",
-	"pantone 186 u":           "#d6515d",
-	"pantone 187 c":           "#ab162b",
-	"pantone 187 u":           "#b15359",
-	"pantone 188 c":           "#79232e",
-	"pantone 188 u":           "#8a5457",
-	"pantone 189 c":           "#fda3ba",
-	"pantone 189 u":           "#ffabc0",
-	"pantone 1895 c":          "#f8b5cc",
-	"pantone 1895 u":          "#ffb3cc",
-	"pantone 190 c":           "#fb7497",
-	"pantone 190 u":           "#ff87a1",
-	"pantone 1905 c":          "#fa9aba",
-	"pantone 1905 u":          "#ff9bb9",
-	"pantone 191 c":           "#f5416c",
-	"pantone 191 u":           "#fc6e88",
-	"pantone 1915 c":          "#f54b7f",
-	"pantone 1915 u":          "#fb6f91",
-	"pantone 192 c":           "#ec0044",
-	"pantone 192 u":           "#f45269",
-	"pantone 1925 c":          "#e9004b",
-	"pantone 1925 u":          "#ee4e6c",
-	"pantone 193 c":           "#c5093b",
-	"pantone 193 u":           "#c95163",
-	"pantone 1935 c":          "#cb003c",
-	"pantone 1935 u":          "#ce4b61",
-	"pantone 194 c":           "#a02741",
-	"pantone 194 u":           "#a65863",
-	"pantone 1945 c":          "#ac063c",
-	"pantone 1945 u":          "#b35467",
-	"pantone 195 c":           "#7b2f3e",
-	"pantone 195 u":           "#895a61",
-	"pantone 1955 c":          "#8f1336",
-	"pantone 1955 u":          "#9a5261",
-	"pantone 196 c":           "#efc6cd",
-	"pantone 196 u":           "#f7c2ca",
-	"pantone 197 c":           "#ee9aac",
-	"pantone 197 u":           "#f59fb1",
-	"pantone 198 c":           "#e74260",
-	"pantone 198 u":           "#ee687b",
-	"pantone 199 c":           "#dc0031",
-	"pantone 199 u":           "#e34d5f",
-	"pantone 200 c":           "#c2002f",
-	"pantone 200 u":           "#c24c5a",
-	"pantone 2001 c":          "#f7e49a",
-	"pantone 2001 u":          "#ffeb9f",
-	"pantone 2002 c":          "#f9e17d",
-	"pantone 2002 u":          "#ffe683",
-	"pantone 2003 c":          "#fae167",
-	"pantone 2003 u":          "#ffe774",
-	"pantone 2004 c":          "#fbdc7a",
-	"pantone 2004 u":          "#ffe17e",
-	"pantone 2005 c":          "#fed781",
-	"pantone 2005 u":          "#ffda82",
-	"pantone 2006 c":          "#ecbc4d",
-	"pantone 2006 u":          "#f2bf65",
-	"pantone 2007 c":          "#e1a423",
-	"pantone 2007 u":          "#e3a753",
-	"pantone 2008 c":          "#f2be70",
-	"pantone 2008 u":          "#fabc77",
-	"pantone 2009 c":          "#f2b562",
-	"pantone 2009 u":          "#fbaf6a",
-	"pantone 201 c":           "#a31f34",
-	"pantone 201 u":           "#a4565e",
-	"pantone 2010 c":          "#ffad00",
-	"pantone 2010 u":          "#ffb300",
-	"pantone 2011 c":          "#f19933",
-	"pantone 2011 u":          "#f89756",
-	"pantone 2012 c":          "#f39500",
-	"pantone 2012 u":          "#fb9841",
-	"pantone 2013 c":          "#ff9800",
-	"pantone 2013 u":          "#ffa01f",
-	"pantone 2014 c":          "#bb7000",
-	"pantone 2014 u":          "#bd823d",
-	"pantone 2015 c":          "#f8cea6",
-	"pantone 2015 u":          "#ffd9b5",
-	"pantone 2016 c":          "#ffb771",
-	"pantone 2016 u":          "#ffc286",
-	"pantone 2017 c":          "#f8ad6a",
-	"pantone 2017 u":          "#ffb77f",
-	"pantone 2018 c":          "#ff7600",
-	"pantone 2018 u":          "#ff8a3d",
-	"pantone 2019 c":          "#da6c00",
-	"pantone 2019 u":          "#e08449",
-	"pantone 202 c":           "#8b2331",
-	"pantone 202 u":           "#92575d",
-	"pantone 2020 c":          "#c05400",
-	"pantone 2020 u":          "#c9713b",
-	"pantone 2021 c":          "#9f4812",
-	"pantone 2021 u":          "#a7633d",
-	"pantone 2022 c":          "#feaa8c",
-	"pantone 2022 u":          "#ffa98e",
-	"pantone 2023 c":          "#fd936e",
-	"pantone 2023 u":          "#ff9b7f",
-	"pantone 2024 c":          "#fd7b55",
-	"pantone 2024 u":          "#ff876e",
-	"pantone 2025 c":          "#ff8a39",
-	"pantone 2025 u":          "#ff955e",
-	"pantone 2026 c":          "#fa6238",
-	"pantone 2026 u":          "#ff755e",
-	"pantone 2027 c":          "#f3523e",
-	"pantone 2027 u":          "#fa6a62",
-	"pantone 2028 c":          "#f13000",
-	"pantone 2028 u":          "#f64f35",
-	"pantone 2029 c":          "#f7817d",
-	"pantone 2029 u":          "#fb8583",
-	"pantone 203 c":           "#f0b2c9",
-	"pantone 203 u":           "#f8b0cb",
-	"pantone 2030 c":          "#e17973",
-	"pantone 2030 u":          "#ea8c89",
-	"pantone 2031 c":          "#da6764",
-	"pantone 2031 u":          "#e07b7b",
-	"pantone 2032 c":          "#d45856",
-	"pantone 2032 u":          "#d77170",
-	"pantone 2033 c":          "#ca4543",
-	"pantone 2033 u":          "#cd6866",
-	"pantone 2034 c":          "#ec3a3d",
-	"pantone 2034 u":          "#f25f62",
-	"pantone 2035 c":          "#dc001b",
-	"pantone 2035 u":          "#e43f41",
-	"pantone 2036 c":          "#f8bdd5",
-	"pantone 2036 u":          "#ffc3da",
-	"pantone 2037 c":          "#f695c5",
-	"pantone 2037 u":          "#ff9bca",
-	"pantone 2038 c":          "#f460a1",
-	"pantone 2038 u":          "#f772a5",
-	"pantone 2039 c":          "#ea3986",
-	"pantone 2039 u":          "#ef5b8e",
-	"pantone 204 c":           "#ec80a8",
-	"pantone 204 u":           "#f481a4",
-	"pantone 2040 c":          "#e1075a",
-	"pantone 2040 u":          "#e34068",
-	"pantone 2041 c":          "#a3234c",
-	"pantone 2041 u":          "#a44e63",
-	"pantone 2042 c":          "#72273b",
-	"pantone 2042 u":          "#7e4e56",
-	"pantone 2043 c":          "#e9c3d1",
-	"pantone 2043 u":          "#f0c0d1",
-	"pantone 2044 c":          "#e993b5",
-	"pantone 2044 u":          "#ec93b5",
-	"pantone 2045 c":          "#e0739e",
-	"pantone 2045 u":          "#e07b9e",
-	"pantone 2046 c":          "#d25788",
-	"pantone 2046 u":          "#cf6c8d",
-	"pantone 2047 c":          "#a7466e",
-	"pantone 2047 u":          "#a05f77",
-	"pantone 2048 c":          "#983a5f",
-	"pantone 2048 u":          "#965a6e",
-	"pantone 2049 c":          "#862d4a",
-	"pantone 2049 u":          "#8c5463",
-	"pantone 205 c":           "#e7417a",
-	"pantone 205 u":           "#ea5f83",
-	"pantone 2050 c":          "#efd6e4",
-	"pantone 2050 u":          "#f7dbe8",
-	"pantone 2051 c":          "#ddc0cf",
-	"pantone 2051 u":          "#e5c7d4",
-	"pantone 2052 c":          "#cb9fb6",
-	"pantone 2052 u":          "#cfa5b9",
-	"pantone 2053 c":          "#b67e9d",
-	"pantone 2053 u":          "#ba8aa3",
-	"pantone 2054 c":          "#a06f8c",
-	"pantone 2054 u":          "#a47c92",
-	"pantone 2055 c":          "#925d7b",
-	"pantone 2055 u":          "#997187",
-	"pantone 2056 c":          "#814766",
-	"pantone 2056 u":          "#8a657a",
-	"pantone 2057 c":          "#d291b7",
-	"pantone 2057 u":          "#db9fbf",
-	"pantone 2058 c":          "#bb85a9",
-	"pantone 2058 u":          "#b285a4",
-	"pantone 2059 c":          "#ba7aa0",
-	"pantone 2059 u":          "#bb83a2",
-	"pantone 206 c":           "#d60036",
-	"pantone 206 u":           "#de4164",
-	"pantone 2060 c":          "#cb6aa6",
-	"pantone 2060 u":          "#cc7aaa",
-	"pantone 2061 c":          "#b15188",
-	"pantone 2061 u":          "#b66f93",
-	"pantone 2062 c":          "#b7448c",
-	"pantone 2062 u":          "#b25f8e",
-	"pantone 2063 c":          "#a9307b",
-	"pantone 2063 u":          "#a3537f",
-	"pantone 2064 c":          "#e1a0d2",
-	"pantone 2064 u":          "#e394cb",
-	"pantone 2065 c":          "#dfa7dc",
-	"pantone 2065 u":          "#e1a0da",
-	"pantone 2066 c":          "#d38dd2",
-	"pantone 2066 u":          "#d68cd1",
-	"pantone 2067 c":          "#c269c1",
-	"pantone 2067 u":          "#c174bf",
-	"pantone 2068 c":          "#b44fb3",
-	"pantone 2068 u":          "#b364b2",
-	"pantone 2069 c":          "#a738a6",
-	"pantone 2069 u":          "#a85aa7",
-	"pantone 207 c":           "#ab0032",
-	"pantone 207 u":           "#b34b63",
-	"pantone 2070 c":          "#911d92",
-	"pantone 2070 u":          "#964b95",
-	"pantone 2071 c":          "#c7b1dd",
-	"pantone 2071 u":          "#ccb3e1",
-	"pantone 2072 c":          "#b896d3",
-	"pantone 2072 u":          "#ba98d5",
-	"pantone 2073 c":          "#b094d2",
-	"pantone 2073 u":          "#ad95d3",
-	"pantone 2074 c":          "#9669bd",
-	"pantone 2074 u":          "#9776bb",
-	"pantone 2075 c":          "#8765bb",
-	"pantone 2075 u":          "#8c75ba",
-	"pantone 2076 c":          "#8558b3",
-	"pantone 2076 u":          "#8c6db2",
-	"pantone 2077 c":          "#6e3fa0",
-	"pantone 2077 u":          "#75579d",
-	"pantone 2078 c":          "#b59dbc",
-	"pantone 2078 u":          "#bea6c1",
-	"pantone 2079 c":          "#9c7da2",
-	"pantone 2079 u":          "#a58baa",
-	"pantone 208 c":           "#8b1c40",
-	"pantone 208 u":           "#935466",
-	"pantone 2080 c":          "#9872ab",
-	"pantone 2080 u":          "#987aa9",
-	"pantone 2081 c":          "#875d9c",
-	"pantone 2081 u":          "#8d6f9e",
-	"pantone 2082 c":          "#7a4d90",
-	"pantone 2082 u":          "#836894",
-	"pantone 2083 c":          "#965dc7",
-	"pantone 2083 u":          "#9c71c8",
-	"pantone 2084 c":          "#8037b1",
-	"pantone 2084 u":          "#8a5db2",
-	"pantone 2085 c":          "#dfcce6",
-	"pantone 2085 u":          "#e9d3ec",
-	"pantone 2086 c":          "#a08acb",
-	"pantone 2086 u":          "#a28fcc",
-	"pantone 2087 c":          "#9d7cd3",
-	"pantone 2087 u":          "#a085d7",
-	"pantone 2088 c":          "#835cc7",
-	"pantone 2088 u":          "#866cc5",
-	"pantone 2089 c":          "#7148bc",
-	"pantone 2089 u":          "#7a61b9",
-	"pantone 209 c":           "#73243c",
-	"pantone 209 u":           "#835664",
-	"pantone 2090 c":          "#653ab6",
-	"pantone 2090 u":          "#755ab2",
-	"pantone 2091 c":          "#4b16a0",
-	"pantone 2091 u":          "#674da0",
-	"pantone 2092 c":          "#b9aad4",
-	"pantone 2092 u":          "#b7aad8",
-	"pantone 2093 c":          "#a597c1",
-	"pantone 2093 u":          "#a697bf",
-	"pantone 2094 c":          "#8e7eac",
-	"pantone 2094 u":          "#9284ac",
-	"pantone 2095 c":          "#7965b2",
-	"pantone 2095 u":          "#7f72af",
-	"pantone 2096 c":          "#664da1",
-	"pantone 2096 u":          "#776aa6",
-	"pantone 2097 c":          "#6045b9",
-	"pantone 2097 u":          "#6f5eb7",
-	"pantone 2098 c":          "#4527a9",
-	"pantone 2098 u":          "#6654a8",
-	"pantone 2099 c":          "#b1a2ca",
-	"pantone 2099 u":          "#aea0c9",
-	"pantone 210 c":           "#fd9fc8",
-	"pantone 210 u":           "#ffa2ca",
-	"pantone 2100 c":          "#9e8ec9",
-	"pantone 2100 u":          "#a495cc",
-	"pantone 2101 c":          "#8974d1",
-	"pantone 2101 u":          "#8c7cd0",
-	"pantone 2102 c":          "#6457b0",
-	"pantone 2102 u":          "#726bad",
-	"pantone 2103 c":          "#5848a5",
-	"pantone 2103 u":          "#6b63a4",
-	"pantone 2104 c":          "#483697",
-	"pantone 2104 u":          "#655c9b",
-	"pantone 2105 c":          "#36217b",
-	"pantone 2105 u":          "#615085",
-	"pantone 2106 c":          "#b0b4cd",
-	"pantone 2106 u":          "#b4b8d2",
-	"pantone 2107 c":          "#8f98b9",
-	"pantone 2107 u":          "#97a0be",
-	"pantone 2108 c":          "#777ea9",
-	"pantone 2108 u":          "#7f87a8",
-	"pantone 2109 c":          "#696b99",
-	"pantone 2109 u":          "#757b9c",
-	"pantone 211 c":           "#fb7eb4",
-	"pantone 211 u":           "#ff85b4",
-	"pantone 2110 c":          "#5a5f8f",
-	"pantone 2110 u":          "#6e7494",
-	"pantone 2111 c":          "#444c7e",
-	"pantone 2111 u":          "#636989",
-	"pantone 2112 c":          "#322164",
-	"pantone 2112 u":          "#54476e",
-	"pantone 2113 c":          "#a4afe3",
-	"pantone 2113 u":          "#a7b1e7",
-	"pantone 2114 c":          "#838cc9",
-	"pantone 2114 u":          "#929cd1",
-	"pantone 2115 c":          "#6e78bd",
-	"pantone 2115 u":          "#838dc5",
-	"pantone 2116 c":          "#5e66b1",
-	"pantone 2116 u":          "#7a80ba",
-	"pantone 2117 c":          "#44489b",
-	"pantone 2117 u":          "#6568a1",
-	"pantone 2118 c":          "#323578",
-	"pantone 2118 u":          "#595a83",
-	"pantone 2119 c":          "#2a2d64",
-	"pantone 2119 u":          "#555577",
-	"pantone 212 c":           "#f55096",
-	"pantone 212 u":           "#f96d9c",
-	"pantone 2120 c":          "#bdcaea",
-	"pantone 2120 u":          "#b3c3eb",
-	"pantone 2121 c":          "#8cabd8",
-	"pantone 2121 u":          "#8da9d9",
-	"pantone 2122 c":          "#9aade3",
-	"pantone 2122 u":          "#8da4e2",
-	"pantone 2123 c":          "#7892dd",
-	"pantone 2123 u":          "#738bda",
-	"pantone 2124 c":          "#6e7bd3",
-	"pantone 2124 u":          "#6b77cd",
-	"pantone 2125 c":          "#4f62c8",
-	"pantone 2125 u":          "#646cc3",
-	"pantone 2126 c":          "#273bb2",
-	"pantone 2126 u":          "#565baf",
-	"pantone 2127 c":          "#b6c8e1",
-	"pantone 2127 u":          "#b9cbe4",
-	"pantone 2128 c":          "#95b4e4",
-	"pantone 2128 u":          "#94b3e6",
-	"pantone 2129 c":          "#5a8cda",
-	"pantone 2129 u":          "#6a8edb",
-	"pantone 213 c":           "#ea1d75",
-	"pantone 213 u":           "#ee5483",
-	"pantone 2130 c":          "#5075d0",
-	"pantone 2130 u":          "#637ace",
-	"pantone 2131 c":          "#2e5bbe",
-	"pantone 2131 u":          "#586bba",
-	"pantone 2132 c":          "#0056c1",
-	"pantone 2132 u":          "#4e6dc3",
-	"pantone 2133 c":          "#1356a4",
-	"pantone 2133 u":          "#5470a9",
-	"pantone 2134 c":          "#90a4cf",
-	"pantone 2134 u":          "#9daed6",
-	"pantone 2135 c":          "#738bbf",
-	"pantone 2135 u":          "#8397c7",
-	"pantone 2136 c":          "#8293b8",
-	"pantone 2136 u":          "#8493b5",
-	"pantone 2137 c":          "#6c7eaa",
-	"pantone 2137 u":          "#7784a7",
-	"pantone 2138 c":          "#627592",
-	"pantone 2138 u":          "#6d798f",
-	"pantone 2139 c":          "#4c6896",
-	"pantone 2139 u":          "#627496",
-	"pantone 214 c":           "#d50f67",
-	"pantone 214 u":           "#d65079",
-	"pantone 2140 c":          "#375182",
-	"pantone 2140 u":          "#586689",
-	"pantone 2141 c":          "#88bde8",
-	"pantone 2141 u":          "#7fb8e9",
-	"pantone 2142 c":          "#7bace3",
-	"pantone 2142 u":          "#87b1e7",
-	"pantone 2143 c":          "#3485cc",
-	"pantone 2143 u":          "#5c8ccc",
-	"pantone 2144 c":          "#0065ba",
-	"pantone 2144 u":          "#4b79bc",
-	"pantone 2145 c":          "#004ca7",
-	"pantone 2145 u":          "#416bad",
-	"pantone 2146 c":          "#00348e",
-	"pantone 2146 u":          "#315193",
-	"pantone 2147 c":          "#002576",
-	"pantone 2147 u":          "#3f4e84",
-	"pantone 2148 c":          "#5e8fb3",
-	"pantone 2148 u":          "#7094b5",
-	"pantone 2149 c":          "#4b80a7",
-	"pantone 2149 u":          "#6488a9",
-	"pantone 215 c":           "#af1556",
-	"pantone 215 u":           "#b1506f",
-	"pantone 2150 c":          "#32739d",
-	"pantone 2150 u":          "#5d7e9f",
-	"pantone 2151 c":          "#2169a2",
-	"pantone 2151 u":          "#52739e",
-	"pantone 2152 c":          "#156390",
-	"pantone 2152 u":          "#517292",
-	"pantone 2153 c":          "#00537d",
-	"pantone 2153 u":          "#4e6a87",
-	"pantone 2154 c":          "#00447f",
-	"pantone 2154 u":          "#435f86",
-	"pantone 2155 c":          "#9cb4cb",
-	"pantone 2155 u":          "#a2b9d0",
-	"pantone 2156 c":          "#8aa4c0",
-	"pantone 2156 u":          "#8ea8c4",
-	"pantone 2157 c":          "#7192b4",
-	"pantone 2157 u":          "#7c95b4",
-	"pantone 2158 c":          "#5881a6",
-	"pantone 2158 u":          "#6d89a8",
-	"pantone 2159 c":          "#4b759b",
-	"pantone 2159 u":          "#66809e",
-	"pantone 216 c":           "#802145",
-	"pantone 216 u":           "#8b5465",
-	"pantone 2160 c":          "#3d6891",
-	"pantone 2160 u":          "#5d7694",
-	"pantone 2161 c":          "#245681",
-	"pantone 2161 u":          "#4f6988",
-	"pantone 2162 c":          "#9da4b3",
-	"pantone 2162 u":          "#9ca3b2",
-	"pantone 2163 c":          "#849aaf",
-	"pantone 2163 u":          "#8ea0b2",
-	"pantone 2164 c":          "#7c92a7",
-	"pantone 2164 u":          "#8396a9",
-	"pantone 2165 c":          "#667f98",
-	"pantone 2165 u":          "#748699",
-	"pantone 2166 c":          "#54718a",
-	"pantone 2166 u":          "#6b7d90",
-	"pantone 2167 c":          "#4d6b86",
-	"pantone 2167 u":          "#647689",
-	"pantone 2168 c":          "#22495c",
-	"pantone 2168 u":          "#556774",
-	"pantone 2169 c":          "#7faed2",
-	"pantone 2169 u":          "#80afd3",
-	"pantone 217 c":           "#eebdda",
-	"pantone 217 u":           "#f6c0e1",
-	"pantone 2170 c":          "#5a9ac6",
-	"pantone 2170 u":          "#6094c0",
-	"pantone 2171 c":          "#3f9edf",
-	"pantone 2171 u":          "#4c95dc",
-	"pantone 2172 c":          "#0079d0",
-	"pantone 2172 u":          "#5284d3",
-	"pantone 2173 c":          "#0083d6",
-	"pantone 2173 u":          "#3c81d1",
-	"pantone 2174 c":          "#0075cf",
-	"pantone 2174 u":          "#3379cb",
-	"pantone 2175 c":          "#0068c7",
-	"pantone 2175 u":          "#2072c2",
-	"pantone 2176 c":          "#a4b7bf",
-	"pantone 2176 u":          "#b2c2cb",
-	"pantone 2177 c":          "#7d9eab",
-	"pantone 2177 u":          "#8da7b3",
-	"pantone 2178 c":          "#688d9c",
-	"pantone 2178 u":          "#7c97a3",
-	"pantone 2179 c":          "#4f7888",
-	"pantone 2179 u":          "#6d8896",
-	"pantone 218 c":           "#eb6baf",
-	"pantone 218 u":           "#f482b8",
-	"pantone 2180 c":          "#3c6b7e",
-	"pantone 2180 u":          "#607a88",
-	"pantone 2181 c":          "#29596b",
-	"pantone 2181 u":          "#576f7c",
-	"pantone 2182 c":          "#12485b",
-	"pantone 2182 u":          "#526975",
-	"pantone 2183 c":          "#0090bd",
-	"pantone 2183 u":          "#3b91ba",
-	"pantone 2184 c":          "#0082d4",
-	"pantone 2184 u":          "#2d88d5",
-	"pantone 2185 c":          "#0074a6",
-	"pantone 2185 u":          "#357ea6",
-	"pantone 2186 c":          "#004787",
-	"pantone 2186 u":          "#225e8e",
-	"pantone 2187 c":          "#004477",
-	"pantone 2187 u":          "#375c7e",
-	"pantone 2188 c":          "#00406a",
-	"pantone 2188 u":          "#3a5b78",
-	"pantone 2189 c":          "#003248",
-	"pantone 2189 u":          "#435768",
-	"pantone 219 c":           "#e11282",
-	"pantone 219 u":           "#e95b95",
-	"pantone 2190 c":          "#4fb5e5",
-	"pantone 2190 u":          "#4eb1e9",
-	"pantone 2191 c":          "#00a1e1",
-	"pantone 2191 u":          "#36a2e4",
-	"pantone 2192 c":          "#008edb",
-	"pantone 2192 u":          "#0095dd",
-	"pantone 2193 c":          "#008fdb",
-	"pantone 2193 u":          "#148cd9",
-	"pantone 2194 c":          "#0083d5",
-	"pantone 2194 u":          "#008bd6",
-	"pantone 2195 c":          "#0074ce",
-	"pantone 2195 u":          "#267dcd",
-	"pantone 2196 c":          "#0067b1",
-	"pantone 2196 u":          "#0165a0",
-	"pantone 2197 c":          "#6ed0e7",
-	"pantone 2197 u":          "#63d1ea",
-	"pantone 2198 c":          "#3ec7e4",
-	"pantone 2198 u":          "#30c7e7",
-	"pantone 2199 c":          "#00b8dd",
-	"pantone 2199 u":          "#00b8de",
-	"pantone 220 c":           "#ab004e",
-	"pantone 220 u":           "#b44a73",
-	"pantone 2200 c":          "#00a6c6",
-	"pantone 2200 u":          "#3ea8c7",
-	"pantone 2201 c":          "#00abd8",
-	"pantone 2201 u":          "#00acd7",
-	"pantone 2202 c":          "#00a2e0",
-	"pantone 2202 u":          "#00a8e4",
-	"pantone 2203 c":          "#0085ae",
-	"pantone 2203 u":          "#178bae",
-	"pantone 2204 c":          "#adcbd7",
-	"pantone 2204 u":          "#aaccd9",
-	"pantone 2205 c":          "#88b1c4",
-	"pantone 2205 u":          "#86b0c5",
-	"pantone 2206 c":          "#769db4",
-	"pantone 2206 u":          "#7ea1b7",
-	"pantone 2207 c":          "#6198b2",
-	"pantone 2207 u":          "#6f9bb3",
-	"pantone 2208 c":          "#5287a3",
-	"pantone 2208 u":          "#668da3",
-	"pantone 2209 c":          "#4684a0",
-	"pantone 2209 u":          "#648ca4",
-	"pantone 221 c":           "#970047",
-	"pantone 221 u":           "#a14c6c",
-	"pantone 2210 c":          "#084d71",
-	"pantone 2210 u":          "#47637c",
-	"pantone 2211 c":          "#6f98a3",
-	"pantone 2211 u":          "#7fa2ac",
-	"pantone 2212 c":          "#4e8391",
-	"pantone 2212 u":          "#688b97",
-	"pantone 2213 c":          "#2d6f78",
-	"pantone 2213 u":          "#5c7c82",
-	"pantone 2214 c":          "#2e6c79",
-	"pantone 2214 u":          "#5b7d87",
-	"pantone 2215 c":          "#2c5466",
-	"pantone 2215 u":          "#516874",
-	"pantone 2216 c":          "#2b4b58",
-	"pantone 2216 u":          "#4e616a",
-	"pantone 2217 c":          "#003841",
-	"pantone 2217 u":          "#43565e",
-	"pantone 2218 c":          "#6eaebd",
-	"pantone 2218 u":          "#75afbf",
-	"pantone 2219 c":          "#57a2b3",
-	"pantone 2219 u":          "#67a3b4",
-	"pantone 222 c":           "#701c45",
-	"pantone 222 u":           "#85556a",
-	"pantone 2220 c":          "#4195a9",
-	"pantone 2220 u":          "#6199ab",
-	"pantone 2221 c":          "#278a9f",
-	"pantone 2221 u":          "#548fa0",
-	"pantone 2222 c":          "#0a8098",
-	"pantone 2222 u":          "#4e879b",
-	"pantone 2223 c":          "#00758e",
-	"pantone 2223 u":          "#447e92",
-	"pantone 2224 c":          "#006681",
-	"pantone 2224 u":          "#3c7386",
-	"pantone 2225 c":          "#70d3e1",
-	"pantone 2225 u":          "#69d6e5",
-	"pantone 2226 c":          "#2ec9da",
-	"pantone 2226 u":          "#39cbdd",
-	"pantone 2227 c":          "#52bcc9",
-	"pantone 2227 u":          "#5dbecd",
-	"pantone 2228 c":          "#00a2be",
-	"pantone 2228 u":          "#00a4be",
-	"pantone 2229 c":          "#0099b8",
-	"pantone 2229 u":          "#009bb6",
-	"pantone 223 c":           "#f294cd",
-	"pantone 223 u":           "#fca4d6",
-	"pantone 2230 c":          "#0089a1",
-	"pantone 2230 u":          "#1490a5",
-	"pantone 2231 c":          "#007993",
-	"pantone 2231 u":          "#008297",
-	"pantone 2232 c":          "#6daeb3",
-	"pantone 2232 u":          "#75afb5",
-	"pantone 2233 c":          "#54a5b0",
-	"pantone 2233 u":          "#63a4af",
-	"pantone 2234 c":          "#499da5",
-	"pantone 2234 u":          "#5c9ba2",
-	"pantone 2235 c":          "#1c919d",
-	"pantone 2235 u":          "#4e939d",
-	"pantone 2236 c":          "#1e909a",
-	"pantone 2236 u":          "#4e8e97",
-	"pantone 2237 c":          "#00848e",
-	"pantone 2237 u":          "#4d8990",
-	"pantone 2238 c":          "#006776",
-	"pantone 2238 u":          "#30737d",
-	"pantone 2239 c":          "#00ccb7",
-	"pantone 2239 u":          "#00ceb6",
-	"pantone 224 c":           "#ef6eba",
-	"pantone 224 u":           "#f987c6",
-	"pantone 2240 c":          "#00bea1",
-	"pantone 2240 u":          "#00bfa4",
-	"pantone 2241 c":          "#53a092",
-	"pantone 2241 u":          "#63a094",
-	"pantone 2242 c":          "#00a077",
-	"pantone 2242 u":          "#00a081",
-	"pantone 2243 c":          "#00927b",
-	"pantone 2243 u":          "#3c9383",
-	"pantone 2244 c":          "#087e6d",
-	"pantone 2244 u":          "#4d857b",
-	"pantone 2245 c":          "#008356",
-	"pantone 2245 u":          "#008a6c",
-	"pantone 2246 c":          "#a7d2b9",
-	"pantone 2246 u":          "#a5d6bc",
-	"pantone 2247 c":          "#74bf99",
-	"pantone 2247 u":          "#7bc4a0",
-	"pantone 2248 c":          "#6cb691",
-	"pantone 2248 u":          "#78ba98",
-	"pantone 2249 c":          "#53a97f",
-	"pantone 2249 u":          "#67aa86",
-	"pantone 225 c":           "#e51992",
-	"pantone 225 u":           "#ef60a4",
-	"pantone 2250 c":          "#00b073",
-	"pantone 2250 u":          "#21ae7b",
-	"pantone 2251 c":          "#00aa71",
-	"pantone 2251 u":          "#00a778",
-	"pantone 2252 c":          "#00a54d",
-	"pantone 2252 u":          "#00a763",
-	"pantone 2253 c":          "#bae8ca",
-	"pantone 2253 u":          "#bfefce",
-	"pantone 2254 c":          "#acddb2",
-	"pantone 2254 u":          "#ace2b4",
-	"pantone 2255 c":          "#85d095",
-	"pantone 2255 u":          "#86d59b",
-	"pantone 2256 c":          "#53c072",
-	"pantone 2256 u":          "#5dc380",
-	"pantone 2257 c":          "#00ab51",
-	"pantone 2257 u":          "#34aa68",
-	"pantone 2258 c":          "#008424",
-	"pantone 2258 u":          "#418f53",
-	"pantone 2259 c":          "#007022",
-	"pantone 2259 u":          "#458151",
-	"pantone 226 c":           "#d7006d",
-	"pantone 226 u":           "#e14486",
-	"pantone 2260 c":          "#b2cfad",
-	"pantone 2260 u":          "#c0dabb",
-	"pantone 2261 c":          "#96ba91",
-	"pantone 2261 u":          "#93b78e",
-	"pantone 2262 c":          "#88ac89",
-	"pantone 2262 u":          "#8bac8c",
-	"pantone 2263 c":          "#6f946b",
-	"pantone 2263 u":          "#7c9a7b",
-	"pantone 2264 c":          "#669460",
-	"pantone 2264 u":          "#71966f",
-	"pantone 2265 c":          "#587d50",
-	"pantone 2265 u":          "#738e70",
-	"pantone 2266 c":          "#2d5423",
-	"pantone 2266 u":          "#577754",
-	"pantone 2267 c":          "#96de99",
-	"pantone 2267 u":          "#98e69f",
-	"pantone 2268 c":          "#72d77d",
-	"pantone 2268 u":          "#6edb84",
-	"pantone 2269 c":          "#78ca6d",
-	"pantone 2269 u":          "#6bc774",
-	"pantone 227 c":           "#b00060",
-	"pantone 227 u":           "#b24776",
-	"pantone 2270 c":          "#17c54e",
-	"pantone 2270 u":          "#3ac867",
-	"pantone 2271 c":          "#00b832",
-	"pantone 2271 u":          "#12ba58",
-	"pantone 2272 c":          "#00981a",
-	"pantone 2272 u":          "#3ba047",
-	"pantone 2273 c":          "#227026",
-	"pantone 2273 u":          "#4e7c4e",
-	"pantone 2274 c":          "#d0d9b1",
-	"pantone 2274 u":          "#d6dfb5",
-	"pantone 2275 c":          "#c3da95",
-	"pantone 2275 u":          "#c7e09a",
-	"pantone 2276 c":          "#88a650",
-	"pantone 2276 u":          "#8aa667",
-	"pantone 2277 c":          "#52941d",
-	"pantone 2277 u":          "#649847",
-	"pantone 2278 c":          "#4b7f1e",
-	"pantone 2278 u":          "#628b4a",
-	"pantone 2279 c":          "#5c7d2a",
-	"pantone 2279 u":          "#6d8453",
-	"pantone 228 c":           "#8d0b56",
-	"pantone 228 u":           "#8f4d6d",
-	"pantone 2280 c":          "#47691d",
-	"pantone 2280 u":          "#627a4b",
-	"pantone 2281 c":          "#d8e798",
-	"pantone 2281 u":          "#dbec99",
-	"pantone 2282 c":          "#c6e895",
-	"pantone 2282 u":          "#c7ed97",
-	"pantone 2283 c":          "#a3df65",
-	"pantone 2283 u":          "#a6e474",
-	"pantone 2284 c":          "#b0d37d",
-	"pantone 2284 u":          "#b0d683",
-	"pantone 2285 c":          "#8fd84a",
-	"pantone 2285 u":          "#8fdd63",
-	"pantone 2286 c":          "#74ca00",
-	"pantone 2286 u":          "#7bd145",
-	"pantone 2287 c":          "#54c400",
-	"pantone 2287 u":          "#5cc647",
-	"pantone 2288 c":          "#c8e179",
-	"pantone 2288 u":          "#c9e681",
-	"pantone 2289 c":          "#c5d779",
-	"pantone 2289 u":          "#c6dc84",
-	"pantone 229 c":           "#692044",
-	"pantone 229 u":           "#7b5365",
-	"pantone 2290 c":          "#a7d91d",
-	"pantone 2290 u":          "#aae053",
-	"pantone 2291 c":          "#a4d300",
-	"pantone 2291 u":          "#a2d834",
-	"pantone 2292 c":          "#91c712",
-	"pantone 2292 u":          "#89c648",
-	"pantone 2293 c":          "#86c300",
-	"pantone 2293 u":          "#8acb23",
-	"pantone 2294 c":          "#74a800",
-	"pantone 2294 u":          "#7eb03b",
-	"pantone 2295 c":          "#e1ea89",
-	"pantone 2295 u":          "#e5f08c",
-	"pantone 2296 c":          "#d5e664",
-	"pantone 2296 u":          "#d6ec70",
-	"pantone 2297 c":          "#bcde16",
-	"pantone 2297 u":          "#bfe44d",
-	"pantone 2298 c":          "#b6d955",
-	"pantone 2298 u":          "#afdb66",
-	"pantone 2299 c":          "#a2d033",
-	"pantone 2299 u":          "#9bd154",
-	"pantone 230 c":           "#f7a6d6",
-	"pantone 230 u":           "#ffb2de",
-	"pantone 2300 c":          "#a8c140",
-	"pantone 2300 u":          "#abc663",
-	"pantone 2301 c":          "#8dac14",
-	"pantone 2301 u":          "#8fad4d",
-	"pantone 2302 c":          "#aeb762",
-	"pantone 2302 u":          "#a9b36e",
-	"pantone 2303 c":          "#9fb156",
-	"pantone 2303 u":          "#9eb16d",
-	"pantone 2304 c":          "#a0aa4d",
-	"pantone 2304 u":          "#9da763",
-	"pantone 2305 c":          "#9da600",
-	"pantone 2305 u":          "#9ca647",
-	"pantone 2306 c":          "#7e8a24",
-	"pantone 2306 u":          "#818c50",
-	"pantone 2307 c":          "#676e11",
-	"pantone 2307 u":          "#767e40",
-	"pantone 2308 c":          "#59561a",
-	"pantone 2308 u":          "#6a6941",
-	"pantone 2309 c":          "#e7cdb5",
-	"pantone 2309 u":          "#eccfb3",
-	"pantone 231 c":           "#f677c4",
-	"pantone 231 u":           "#fc85c8",
-	"pantone 2310 c":          "#d8bfa7",
-	"pantone 2310 u":          "#ddc0a7",
-	"pantone 2311 c":          "#cca784",
-	"pantone 2311 u":          "#cca88a",
-	"pantone 2312 c":          "#b79a80",
-	"pantone 2312 u":          "#baa08b",
-	"pantone 2313 c":          "#c39365",
-	"pantone 2313 u":          "#c19676",
-	"pantone 2314 c":          "#ac7743",
-	"pantone 2314 u":          "#a98062",
-	"pantone 2315 c":          "#93480d",
-	"pantone 2315 u":          "#96623e",
-	"pantone 2316 c":          "#bd9979",
-	"pantone 2316 u":          "#c09f85",
-	"pantone 2317 c":          "#b4865c",
-	"pantone 2317 u":          "#b18c70",
-	"pantone 2318 c":          "#a47447",
-	"pantone 2318 u":          "#a17d63",
-	"pantone 2319 c":          "#8d5b2f",
-	"pantone 2319 u":          "#8d6d55",
-	"pantone 232 c":           "#ef3fa9",
-	"pantone 232 u":           "#f56ab4",
-	"pantone 2320 c":          "#714621",
-	"pantone 2320 u":          "#795c46",
-	"pantone 2321 c":          "#6f5036",
-	"pantone 2321 u":          "#7a695b",
-	"pantone 2322 c":          "#4d3423",
-	"pantone 2322 u":          "#625140",
-	"pantone 2323 c":          "#b7b098",
-	"pantone 2323 u":          "#c5bfaa",
-	"pantone 2324 c":          "#b2a282",
-	"pantone 2324 u":          "#ada189",
-	"pantone 2325 c":          "#9f9073",
-	"pantone 2325 u":          "#a09580",
-	"pantone 2326 c":          "#8b7c5e",
-	"pantone 2326 u":          "#958b78",
-	"pantone 2327 c":          "#837857",
-	"pantone 2327 u":          "#908875",
-	"pantone 2328 c":          "#786747",
-	"pantone 2328 u":          "#807766",
-	"pantone 2329 c":          "#69583b",
-	"pantone 2329 u":          "#766e61",
-	"pantone 233 c":           "#cc007a",
-	"pantone 233 u":           "#cc4585",
-	"pantone 2330 c":          "#cfccc8",
-	"pantone 2330 u":          "#cfccc8",
-	"pantone 2331 c":          "#aca7a3",
-	"pantone 2331 u":          "#b0aba9",
-	"pantone 2332 c":          "#8b8985",
-	"pantone 2332 u":          "#8b8986",
-	"pantone 2333 c":          "#716c69",
-	"pantone 2333 u":          "#757473",
-	"pantone 2334 c":          "#6d6361",
-	"pantone 2334 u":          "#706c6c",
-	"pantone 2335 c":          "#5e4e46",
-	"pantone 2335 u":          "#706964",
-	"pantone 2336 c":          "#4e4946",
-	"pantone 2336 u":          "#666565",
-	"pantone 2337 c":          "#fac0b8",
-	"pantone 2337 u":          "#ffb6ad",
-	"pantone 2338 c":          "#e0a39d",
-	"pantone 2338 u":          "#dc9b95",
-	"pantone 2339 c":          "#f29390",
-	"pantone 2339 u":          "#f5908e",
-	"pantone 234 c":           "#a60063",
-	"pantone 234 u":           "#ac4c7b",
-	"pantone 2340 c":          "#d26e76",
-	"pantone 2340 u":          "#cc797d",
-	"pantone 2341 c":          "#b96b6c",
-	"pantone 2341 u":          "#ae7475",
-	"pantone 2342 c":          "#ba5963",
-	"pantone 2342 u":          "#b16c73",
-	"pantone 2343 c":          "#aa5564",
-	"pantone 2343 u":          "#a86c75",
-	"pantone 2344 c":          "#f3806e",
-	"pantone 2344 u":          "#f08576",
-	"pantone 2345 c":          "#ff6e67",
-	"pantone 2345 u":          "#ff7d74",
-	"pantone 2346 c":          "#ff5967",
-	"pantone 2346 u":          "#ff7075",
-	"pantone 2347 c":          "#e80000",
-	"pantone 2347 u":          "#ed4336",
-	"pantone 2348 c":          "#ea534d",
-	"pantone 2348 u":          "#e1615c",
-	"pantone 2349 c":          "#ce3402",
-	"pantone 2349 u":          "#c44d36",
-	"pantone 235 c":           "#880d53",
-	"pantone 235 u":           "#924e70",
-	"pantone 2350 c":          "#b5201b",
-	"pantone 2350 u":          "#a1463d",
-	"pantone 2351 c":          "#bf79b2",
-	"pantone 2351 u":          "#bd81b1",
-	"pantone 2352 c":          "#b764a6",
-	"pantone 2352 u":          "#b471a3",
-	"pantone 2353 c":          "#c44ca5",
-	"pantone 2353 u":          "#b85997",
-	"pantone 2354 c":          "#944d8a",
-	"pantone 2354 u":          "#90638b",
-	"pantone 2355 c":          "#8b0079",
-	"pantone 2355 u":          "#86467a",
-	"pantone 2356 c":          "#750060",
-	"pantone 2356 u":          "#7e3f6f",
-	"pantone 2357 c":          "#641143",
-	"pantone 2357 u":          "#6e435a",
-	"pantone 2358 c":          "#ada3ae",
-	"pantone 2358 u":          "#a499a5",
-	"pantone 2359 c":          "#7e7283",
-	"pantone 2359 u":          "#807785",
-	"pantone 236 c":           "#f4a7dc",
-	"pantone 236 u":           "#faa6dd",
-	"pantone 2360 c":          "#7a788e",
-	"pantone 2360 u":          "#7f7c8d",
-	"pantone 2361 c":          "#6f6f8b",
-	"pantone 2361 u":          "#75758c",
-	"pantone 2362 c":          "#6d6980",
-	"pantone 2362 u":          "#787585",
-	"pantone 2363 c":          "#5f5c75",
-	"pantone 2363 u":          "#6f6c7c",
-	"pantone 2364 c":          "#67586d",
-	"pantone 2364 u":          "#726976",
-	"pantone 2365 c":          "#f2b9df",
-	"pantone 2365 u":          "#f9bee6",
-	"pantone 2366 c":          "#676ccd",
-	"pantone 2366 u":          "#6f71c7",
-	"pantone 2367 c":          "#4d4bb0",
-	"pantone 2367 u":          "#6663ad",
-	"pantone 2368 c":          "#4a49bc",
-	"pantone 2368 u":          "#6160b7",
-	"pantone 2369 c":          "#3640b5",
-	"pantone 2369 u":          "#5b5cb0",
-	"pantone 237 c":           "#f185ce",
-	"pantone 237 u":           "#f58dcf",
-	"pantone 2370 c":          "#2b2b93",
-	"pantone 2370 u":          "#5d599b",
-	"pantone 2371 c":          "#300b8c",
-	"pantone 2371 u":          "#58468c",
-	"pantone 2372 c":          "#251e77",
-	"pantone 2372 u":          "#524d7f",
-	"pantone 2373 c":          "#7181a0",
-	"pantone 2373 u":          "#78829b",
-	"pantone 2374 c":          "#4d5e80",
-	"pantone 2374 u":          "#5e687f",
-	"pantone 2375 c":          "#e677cb",
-	"pantone 2375 u":          "#ed88d1",
-	"pantone 2376 c":          "#4d5a72",
-	"pantone 2376 u":          "#636c7d",
-	"pantone 2377 c":          "#305271",
-	"pantone 2377 u":          "#55687c",
-	"pantone 2378 c":          "#374767",
-	"pantone 2378 u":          "#555d71",
-	"pantone 2379 c":          "#3b4358",
-	"pantone 2379 u":          "#565a66",
-	"pantone 238 c":           "#ea5dbd",
-	"pantone 238 u":           "#ed72bf",
-	"pantone 2380 c":          "#273248",
-	"pantone 2380 u":          "#525664",
-	"pantone 2381 c":          "#5992db",
-	"pantone 2381 u":          "#618dd8",
-	"pantone 2382 c":          "#0088d8",
-	"pantone 2382 u":          "#3d82d2",
-	"pantone 2383 c":          "#2173ad",
-	"pantone 2383 u":          "#4a72a2",
-	"pantone 2384 c":          "#0060a1",
-	"pantone 2384 u":          "#3b6796",
-	"pantone 2385 c":          "#d939b2",
-	"pantone 2385 u":          "#e56fc0",
-	"pantone 2386 c":          "#2768c3",
-	"pantone 2386 u":          "#546fbd",
-	"pantone 2387 c":          "#0061c8",
-	"pantone 2387 u":          "#4767bd",
-	"pantone 2388 c":          "#004fb5",
-	"pantone 2388 u":          "#3c5ea8",
-	"pantone 2389 c":          "#449ec9",
-	"pantone 2389 u":          "#5b9cc6",
-	"pantone 239 c":           "#e03eae",
-	"pantone 239 u":           "#e055a7",
-	"pantone 2390 c":          "#007bab",
-	"pantone 2390 u":          "#4e83aa",
-	"pantone 2391 c":          "#0080a5",
-	"pantone 2391 u":          "#4683a1",
-	"pantone 2392 c":          "#096785",
-	"pantone 2392 u":          "#587b90",
-	"pantone 2393 c":          "#0090cb",
-	"pantone 2393 u":          "#0095cc",
-	"pantone 2394 c":          "#0089ce",
-	"pantone 2394 u":          "#008fce",
-	"pantone 2395 c":          "#cf009e",
-	"pantone 2395 u":          "#d956ac",
-	"pantone 2396 c":          "#0096b2",
-	"pantone 2396 u":          "#0093ab",
-	"pantone 2397 c":          "#00b4bd",
-	"pantone 2397 u":          "#00b1ba",
-	"pantone 2398 c":          "#00b8b3",
-	"pantone 2398 u":          "#2cbbb6",
-	"pantone 2399 c":          "#00a8a2",
-	"pantone 2399 u":          "#00ada8",
-	"pantone 240 c":           "#cb2b99",
-	"pantone 240 u":           "#c55597",
-	"pantone 2400 c":          "#00ad97",
-	"pantone 2400 u":          "#00ae9a",
-	"pantone 2401 c":          "#28a598",
-	"pantone 2401 u":          "#52a69b",
-	"pantone 2402 c":          "#009a87",
-	"pantone 2402 u":          "#009e8d",
-	"pantone 2403 c":          "#00867b",
-	"pantone 2403 u":          "#008d85",
-	"pantone 2404 c":          "#a4b79c",
-	"pantone 2404 u":          "#9fb39a",
-	"pantone 2405 c":          "#b5008c",
-	"pantone 2405 u":          "#be569c",
-	"pantone 2406 c":          "#809c87",
-	"pantone 2406 u":          "#869f8f",
-	"pantone 2407 c":          "#698b72",
-	"pantone 2407 u":          "#779080",
-	"pantone 2408 c":          "#477956",
-	"pantone 2408 u":          "#597865",
-	"pantone 2409 c":          "#4b6447",
-	"pantone 2409 u":          "#637364",
-	"pantone 241 c":           "#b41782",
-	"pantone 241 u":           "#b15389",
-	"pantone 2410 c":          "#415a3d",
-	"pantone 2410 u":          "#5f6e5f",
-	"pantone 2411 c":          "#1a4020",
-	"pantone 2411 u":          "#4a6149",
-	"pantone 2412 c":          "#3ad398",
-	"pantone 2412 u":          "#5bd8a2",
-	"pantone 2413 c":          "#29b58f",
-	"pantone 2413 u":          "#47af8e",
-	"pantone 2414 c":          "#00b980",
-	"pantone 2414 u":          "#24b280",
-	"pantone 2415 c":          "#a2007c",
-	"pantone 2415 u":          "#ae5490",
-	"pantone 2416 c":          "#00ae77",
-	"pantone 2416 u":          "#17ae81",
-	"pantone 2417 c":          "#189b6b",
-	"pantone 2417 u":          "#4e9c79",
-	"pantone 2418 c":          "#008440",
-	"pantone 2418 u":          "#00845a",
-	"pantone 2419 c":          "#007a57",
-	"pantone 2419 u":          "#367b68",
-	"pantone 242 c":           "#83225e",
-	"pantone 242 u":           "#8e5975",
-	"pantone 2420 c":          "#00c460",
-	"pantone 2420 u":          "#1cc574",
-	"pantone 2421 c":          "#1ab500",
-	"pantone 2421 u":          "#44b738",
-	"pantone 2422 c":          "#00a52e",
-	"pantone 2422 u":          "#31a557",
-	"pantone 2423 c":          "#00a815",
-	"pantone 2423 u":          "#00aa49",
-	"pantone 2424 c":          "#1a9d00",
-	"pantone 2424 u":          "#46a23a",
-	"pantone 2425 c":          "#880063",
-	"pantone 2425 u":          "#98537f",
-	"pantone 2426 c":          "#008918",
-	"pantone 2426 u":          "#009247",
-	"pantone 2427 c":          "#005d1c",
-	"pantone 2427 u":          "#44704a",
-	"pantone 243 c":           "#eeb7e2",
-	"pantone 243 u":           "#f4b1e4",
-	"pantone 244 c":           "#e99bda",
-	"pantone 244 u":           "#ee9add",
-	"pantone 245 c":           "#e37ed1",
-	"pantone 245 u":           "#e47ece",
-	"pantone 246 c":           "#cd25af",
-	"pantone 246 u":           "#d356b1",
-	"pantone 247 c":           "#c114a1",
-	"pantone 247 u":           "#c150a0",
-	"pantone 248 c":           "#a9198d",
-	"pantone 248 u":           "#a44e8a",
-	"pantone 249 c":           "#84266a",
-	"pantone 249 u":           "#895677",
-	"pantone 250 c":           "#eab9e4",
-	"pantone 250 u":           "#f0b8e7",
-	"pantone 251 c":           "#e19bdf",
-	"pantone 251 u":           "#e495dd",
-	"pantone 252 c":           "#d162cd",
-	"pantone 252 u":           "#d16fcb",
-	"pantone 253 c":           "#b319ab",
-	"pantone 253 u":           "#b856ac",
-	"pantone 254 c":           "#9e1a96",
-	"pantone 254 u":           "#a15499",
-	"pantone 255 c":           "#77216c",
-	"pantone 255 u":           "#83577c",
-	"pantone 256 c":           "#dabddc",
-	"pantone 256 u":           "#d5b4da",
-	"pantone 2562 c":          "#dba8e2",
-	"pantone 2562 u":          "#dface8",
-	"pantone 2563 c":          "#cea2d7",
-	"pantone 2563 u":          "#cfa1d9",
-	"pantone 2567 c":          "#c49fd8",
-	"pantone 2567 u":          "#c19eda",
-	"pantone 257 c":           "#c9a0ce",
-	"pantone 257 u":           "#c499c9",
-	"pantone 2572 c":          "#cc8ada",
-	"pantone 2572 u":          "#d08fdc",
-	"pantone 2573 c":          "#bc83c9",
-	"pantone 2573 u":          "#ba87ca",
-	"pantone 2577 c":          "#aa7bc9",
-	"pantone 2577 u":          "#a47ec5",
-	"pantone 258 c":           "#904799",
-	"pantone 258 u":           "#a275ad",
-	"pantone 2582 c":          "#b24ec4",
-	"pantone 2582 u":          "#bd75ce",
-	"pantone 2583 c":          "#a35db4",
-	"pantone 2583 u":          "#a571b5",
-	"pantone 2587 c":          "#8446ac",
-	"pantone 2587 u":          "#936cb5",
-	"pantone 259 c":           "#702076",
-	"pantone 259 u":           "#95659b",
-	"pantone 2592 c":          "#9f26b4",
-	"pantone 2592 u":          "#ad60be",
-	"pantone 2593 c":          "#873299",
-	"pantone 2593 u":          "#8f5e9f",
-	"pantone 2597 c":          "#5d0c8b",
-	"pantone 2597 u":          "#825aa3",
-	"pantone 260 c":           "#672565",
-	"pantone 260 u":           "#8b648c",
-	"pantone 2602 c":          "#8b189b",
-	"pantone 2602 u":          "#9a57a5",
-	"pantone 2603 c":          "#732181",
-	"pantone 2603 u":          "#86588f",
-	"pantone 2607 c":          "#520975",
-	"pantone 2607 u":          "#7a5894",
-	"pantone 261 c":           "#5f285e",
-	"pantone 261 u":           "#816181",
-	"pantone 2612 c":          "#7b2481",
-	"pantone 2612 u":          "#88558c",
-	"pantone 2613 c":          "#6a1e74",
-	"pantone 2613 u":          "#7f5685",
-	"pantone 2617 c":          "#490c66",
-	"pantone 2617 u":          "#745387",
-	"pantone 262 c":           "#54274e",
-	"pantone 262 u":           "#765d73",
-	"pantone 2622 c":          "#682f64",
-	"pantone 2622 u":          "#7d5a79",
-	"pantone 2623 c":          "#621f65",
-	"pantone 2623 u":          "#76547a",
-	"pantone 2627 c":          "#3e1051",
-	"pantone 2627 u":          "#6d527a",
-	"pantone 263 c":           "#dac4e5",
-	"pantone 263 u":           "#dcc4e8",
-	"pantone 2635 c":          "#c6b3e1",
-	"pantone 2635 u":          "#c8b6e5",
-	"pantone 264 c":           "#c4a6e1",
-	"pantone 264 u":           "#bfa0e3",
-	"pantone 2645 c":          "#b095da",
-	"pantone 2645 u":          "#b8a2e2",
-	"pantone 265 c":           "#9363cc",
-	"pantone 265 u":           "#9d7ad2",
-	"pantone 2655 c":          "#9678d2",
-	"pantone 2655 u":          "#a188d7",
-	"pantone 266 c":           "#783cbd",
-	"pantone 266 u":           "#8a66c2",
-	"pantone 2665 c":          "#7f56c5",
-	"pantone 2665 u":          "#8b70c8",
-	"pantone 267 c":           "#62259d",
-	"pantone 267 u":           "#7f5dac",
-	"pantone 268 c":           "#5a2b81",
-	"pantone 268 u":           "#735990",
-	"pantone 2685 c":          "#32006e",
-	"pantone 2685 u":          "#735ba5",
-	"pantone 269 c":           "#532c6c",
-	"pantone 269 u":           "#6e577e",
-	"pantone 2695 c":          "#2f1a45",
-	"pantone 2695 u":          "#6b5d7e",
-	"pantone 270 c":           "#b6b3df",
-	"pantone 270 u":           "#adaddd",
-	"pantone 2705 c":          "#a9a3df",
-	"pantone 2705 u":          "#b8b4e8",
-	"pantone 2706 c":          "#ccd2e9",
-	"pantone 2706 u":          "#cad3f0",
-	"pantone 2707 c":          "#c4d5ec",
-	"pantone 2707 u":          "#c6daf5",
-	"pantone 2708 c":          "#b9cbea",
-	"pantone 2708 u":          "#b2c8ee",
-	"pantone 271 c":           "#9794d2",
-	"pantone 271 u":           "#9a9ad4",
-	"pantone 2715 c":          "#8b83d6",
-	"pantone 2715 u":          "#9c98df",
-	"pantone 2716 c":          "#9fade4",
-	"pantone 2716 u":          "#a1aee9",
-	"pantone 2717 c":          "#a7c5ed",
-	"pantone 2717 u":          "#a7c7f3",
-	"pantone 2718 c":          "#5a87d9",
-	"pantone 2718 u":          "#6786d7",
-	"pantone 272 c":           "#7573c0",
-	"pantone 272 u":           "#8585c5",
-	"pantone 2725 c":          "#675dc6",
-	"pantone 2725 u":          "#8880d2",
-	"pantone 2726 c":          "#455cc7",
-	"pantone 2726 u":          "#6e7ace",
-	"pantone 2727 c":          "#2e7de1",
-	"pantone 2727 u":          "#618ee8",
-	"pantone 2728 c":          "#0047ba",
-	"pantone 2728 u":          "#4d68c1",
-	"pantone 273 c":           "#23135e",
-	"pantone 273 u":           "#645d9a",
-	"pantone 2735 c":          "#290088",
-	"pantone 2735 u":          "#6658b0",
-	"pantone 2736 c":          "#1724a9",
-	"pantone 2736 u":          "#5960b9",
-	"pantone 2738 c":          "#00098b",
-	"pantone 2738 u":          "#424da3",
-	"pantone 274 c":           "#211650",
-	"pantone 274 u":           "#5f578a",
-	"pantone 2745 c":          "#250670",
-	"pantone 2745 u":          "#5f5499",
-	"pantone 2746 c":          "#101e8e",
-	"pantone 2746 u":          "#565da3",
-	"pantone 2747 c":          "#001a71",
-	"pantone 2747 u":          "#3f4f90",
-	"pantone 2748 c":          "#001970",
-	"pantone 2748 u":          "#454f8c",
-	"pantone 275 c":           "#211646",
-	"pantone 275 u":           "#5c5582",
-	"pantone 2755 c":          "#221060",
-	"pantone 2755 u":          "#5b5389",
-	"pantone 2756 c":          "#121f6b",
-	"pantone 2756 u":          "#565b8b",
-	"pantone 2757 c":          "#001e5f",
-	"pantone 2757 u":          "#444e7d",
-	"pantone 2758 c":          "#001d60",
-	"pantone 2758 u":          "#464d79",
-	"pantone 276 c":           "#231c34",
-	"pantone 276 u":           "#5b546d",
-	"pantone 2765 c":          "#201545",
-	"pantone 2765 u":          "#574f76",
-	"pantone 2766 c":          "#131b4d",
-	"pantone 2766 u":          "#57597b",
-	"pantone 2767 c":          "#14284b",
-	"pantone 2767 u":          "#484b63",
-	"pantone 2768 c":          "#051c48",
-	"pantone 2768 u":          "#494c6b",
-	"pantone 277 c":           "#adc8e7",
-	"pantone 277 u":           "#accdf1",
-	"pantone 278 c":           "#8cb7e8",
-	"pantone 278 u":           "#8bb8ed",
-	"pantone 279 c":           "#3e8ddd",
-	"pantone 279 u":           "#578dda",
-	"pantone 280 c":           "#012168",
-	"pantone 280 u":           "#3a487f",
-	"pantone 281 c":           "#001f5b",
-	"pantone 281 u":           "#3f4974",
-	"pantone 282 c":           "#031d40",
-	"pantone 282 u":           "#414765",
-	"pantone 283 c":           "#94c0e9",
-	"pantone 283 u":           "#9ac5ed",
-	"pantone 284 c":           "#6caae4",
-	"pantone 284 u":           "#76afe8",
-	"pantone 285 c":           "#0070cd",
-	"pantone 285 u":           "#4280cf",
-	"pantone 286 c":           "#0032a0",
-	"pantone 286 u":           "#2c54a3",
-	"pantone 287 c":           "#002f86",
-	"pantone 287 u":           "#395796",
-	"pantone 288 c":           "#002c73",
-	"pantone 288 u":           "#3b5486",
-	"pantone 289 c":           "#0c223f",
-	"pantone 289 u":           "#424e6a",
-	"pantone 290 c":           "#bad8ea",
-	"pantone 290 u":           "#a5d2ee",
-	"pantone 2905 c":          "#8fc6e8",
-	"pantone 2905 u":          "#88c7ed",
-	"pantone 291 c":           "#99caea",
-	"pantone 291 u":           "#7cbceb",
-	"pantone 2915 c":          "#61b3e4",
-	"pantone 2915 u":          "#61b1e8",
-	"pantone 292 c":           "#67b2e7",
-	"pantone 292 u":           "#5fa7e5",
-	"pantone 2925 c":          "#009add",
-	"pantone 2925 u":          "#3795da",
-	"pantone 293 c":           "#003ca6",
-	"pantone 293 u":           "#1a59a8",
-	"pantone 2935 c":          "#0055b7",
-	"pantone 2935 u":          "#0f5ea9",
-	"pantone 294 c":           "#002e6c",
-	"pantone 294 u":           "#385888",
-	"pantone 2945 c":          "#004a97",
-	"pantone 2945 u":          "#26578b",
-	"pantone 295 c":           "#002855",
-	"pantone 295 u":           "#344f71",
-	"pantone 2955 c":          "#003763",
-	"pantone 2955 u":          "#395575",
-	"pantone 296 c":           "#061b2b",
-	"pantone 296 u":           "#424e5f",
-	"pantone 2965 c":          "#00253d",
-	"pantone 2965 u":          "#3a5165",
-	"pantone 297 c":           "#6ec4e8",
-	"pantone 297 u":           "#79caed",
-	"pantone 2975 c":          "#97d4e9",
-	"pantone 2975 u":          "#88d4ee",
-	"pantone 298 c":           "#40b4e5",
-	"pantone 298 u":           "#47b2e8",
-	"pantone 2985 c":          "#57c0e8",
-	"pantone 2985 u":          "#41b5e6",
-	"pantone 299 c":           "#00a0df",
-	"pantone 299 u":           "#0093d9",
-	"pantone 2995 c":          "#00a7e1",
-	"pantone 2995 u":          "#009adc",
-	"pantone 300 c":           "#005cb8",
-	"pantone 300 u":           "#006cb7",
-	"pantone 3005 c":          "#0074c8",
-	"pantone 3005 u":          "#0076bf",
-	"pantone 301 c":           "#004987",
-	"pantone 301 u":           "#26608d",
-	"pantone 3015 c":          "#005f9b",
-	"pantone 3015 u":          "#176995",
-	"pantone 302 c":           "#003a5c",
-	"pantone 302 u":           "#345c76",
-	"pantone 3025 c":          "#004d71",
-	"pantone 3025 u":          "#345f79",
-	"pantone 303 c":           "#012939",
-	"pantone 303 u":           "#3e5565",
-	"pantone 3035 c":          "#003d51",
-	"pantone 3035 u":          "#3e5665",
-	"pantone 304 c":           "#98dae9",
-	"pantone 304 u":           "#8adbec",
-	"pantone 305 c":           "#57c8e7",
-	"pantone 305 u":           "#49c8ec",
-	"pantone 306 c":           "#00b2e2",
-	"pantone 306 u":           "#00b2e5",
-	"pantone 307 c":           "#0069a6",
-	"pantone 307 u":           "#0071a2",
-	"pantone 308 c":           "#00567c",
-	"pantone 308 u":           "#206682",
-	"pantone 309 c":           "#003a49",
-	"pantone 309 u":           "#3c5763",
-	"pantone 310 c":           "#66cfe3",
-	"pantone 310 u":           "#55cee6",
-	"pantone 3105 c":          "#62d0df",
-	"pantone 3105 u":          "#42ccdf",
-	"pantone 311 c":           "#00c1de",
-	"pantone 311 u":           "#00bbdc",
-	"pantone 3115 c":          "#00bfd5",
-	"pantone 3115 u":          "#00bcd4",
-	"pantone 312 c":           "#00a6ce",
-	"pantone 312 u":           "#00abd1",
-	"pantone 3125 c":          "#00abc7",
-	"pantone 3125 u":          "#00a8c3",
-	"pantone 313 c":           "#008fbe",
-	"pantone 313 u":           "#008ab3",
-	"pantone 3135 c":          "#008aab",
-	"pantone 3135 u":          "#008aa7",
-	"pantone 314 c":           "#007da4",
-	"pantone 314 u":           "#007b9b",
-	"pantone 3145 c":          "#00748c",
-	"pantone 3145 u":          "#007588",
-	"pantone 315 c":           "#00657f",
-	"pantone 315 u":           "#1c6578",
-	"pantone 3155 c":          "#005f71",
-	"pantone 3155 u":          "#186676",
-	"pantone 316 c":           "#00464f",
-	"pantone 316 u":           "#3b5861",
-	"pantone 3165 c":          "#004d58",
-	"pantone 3165 u":          "#2d5a65",
-	"pantone 317 c":           "#b0e2e2",
-	"pantone 317 u":           "#abe7e7",
-	"pantone 318 c":           "#86dade",
-	"pantone 318 u":           "#62d7df",
-	"pantone 319 c":           "#25cad3",
-	"pantone 319 u":           "#17c7d1",
-	"pantone 320 c":           "#0099a8",
-	"pantone 320 u":           "#0099a6",
-	"pantone 321 c":           "#008995",
-	"pantone 321 u":           "#00808b",
-	"pantone 322 c":           "#007078",
-	"pantone 322 u":           "#0f7179",
-	"pantone 323 c":           "#005d62",
-	"pantone 323 u":           "#2c5f64",
-	"pantone 324 c":           "#9bd9d9",
-	"pantone 324 u":           "#7fd6d6",
-	"pantone 3242 c":          "#6edad5",
-	"pantone 3242 u":          "#54d9d3",
-	"pantone 3245 c":          "#7aded4",
-	"pantone 3245 u":          "#45d9cc",
-	"pantone 3248 c":          "#6dcbb8",
-	"pantone 3248 u":          "#65ccba",
-	"pantone 325 c":           "#64cbc9",
-	"pantone 325 u":           "#35bebb",
-	"pantone 3252 c":          "#1bcfc9",
-	"pantone 3252 u":          "#00cac3",
-	"pantone 3255 c":          "#22d3c5",
-	"pantone 3255 u":          "#00ccbc",
-	"pantone 3258 c":          "#48c3b1",
-	"pantone 3258 u":          "#43beac",
-	"pantone 326 c":           "#00afa9",
-	"pantone 326 u":           "#00a8a2",
-	"pantone 3262 c":          "#00bbb3",
-	"pantone 3262 u":          "#00b7b1",
-	"pantone 3265 c":          "#00c4b3",
-	"pantone 3265 u":          "#00bcac",
-	"pantone 3268 c":          "#00a98e",
-	"pantone 3268 u":          "#1eaf99",
-	"pantone 327 c":           "#008374",
-	"pantone 327 u":           "#008d85",
-	"pantone 3272 c":          "#00a19a",
-	"pantone 3272 u":          "#00a19c",
-	"pantone 3275 c":          "#00af9a",
-	"pantone 3275 u":          "#00a694",
-	"pantone 3278 c":          "#009877",
-	"pantone 3278 u":          "#009982",
-	"pantone 328 c":           "#007166",
-	"pantone 328 u":           "#077b79",
-	"pantone 3282 c":          "#00837a",
-	"pantone 3282 u":          "#008480",
-	"pantone 3285 c":          "#009382",
-	"pantone 3285 u":          "#008d82",
-	"pantone 3288 c":          "#008064",
-	"pantone 3288 u":          "#158576",
-	"pantone 329 c":           "#00665e",
-	"pantone 329 u":           "#2a7372",
-	"pantone 3292 c":          "#00574f",
-	"pantone 3292 u":          "#306c6b",
-	"pantone 3295 c":          "#007564",
-	"pantone 3295 u":          "#1c7d74",
-	"pantone 3298 c":          "#006852",
-	"pantone 3298 u":          "#367267",
-	"pantone 330 c":           "#00514b",
-	"pantone 330 u":           "#416261",
-	"pantone 3302 c":          "#004b44",
-	"pantone 3302 u":          "#3e5f5e",
-	"pantone 3305 c":          "#004c42",
-	"pantone 3305 u":          "#436863",
-	"pantone 3308 c":          "#034436",
-	"pantone 3308 u":          "#465f5a",
-	"pantone 331 c":           "#a6e5d8",
-	"pantone 331 u":           "#8fe6d3",
-	"pantone 332 c":           "#89e1d0",
-	"pantone 332 u":           "#6adfcb",
-	"pantone 333 c":           "#33d9c2",
-	"pantone 333 u":           "#00cfb5",
-	"pantone 334 c":           "#009578",
-	"pantone 334 u":           "#009380",
-	"pantone 335 c":           "#00795f",
-	"pantone 335 u":           "#1a7c70",
-	"pantone 336 c":           "#00634f",
-	"pantone 336 u":           "#367068",
-	"pantone 337 c":           "#8fd4bc",
-	"pantone 337 u":           "#7ed3b9",
-	"pantone 3375 c":          "#76e0c0",
-	"pantone 3375 u":          "#55deb7",
-	"pantone 338 c":           "#6dcdb1",
-	"pantone 338 u":           "#45bfa1",
-	"pantone 3385 c":          "#3fd4ad",
-	"pantone 3385 u":          "#2fd2a8",
-	"pantone 339 c":           "#00b188",
-	"pantone 339 u":           "#19ab8a",
-	"pantone 3395 c":          "#00c08a",
-	"pantone 3395 u":          "#00c497",
-	"pantone 340 c":           "#00945d",
-	"pantone 340 u":           "#009b77",
-	"pantone 3405 c":          "#00ac68",
-	"pantone 3405 u":          "#00ae7b",
-	"pantone 341 c":           "#007852",
-	"pantone 341 u":           "#317663",
-	"pantone 3415 c":          "#007549",
-	"pantone 3415 u":          "#2c7f66",
-	"pantone 342 c":           "#006547",
-	"pantone 342 u":           "#3e6d60",
-	"pantone 3425 c":          "#006140",
-	"pantone 3425 u":          "#3d7563",
-	"pantone 343 c":           "#0d553f",
-	"pantone 343 u":           "#45645b",
-	"pantone 3435 c":          "#144633",
-	"pantone 3435 u":          "#49655a",
-	"pantone 344 c":           "#a0d8b3",
-	"pantone 344 u":           "#8bd6ad",
-	"pantone 345 c":           "#91d5ab",
-	"pantone 345 u":           "#68cb9b",
-	"pantone 346 c":           "#6eca97",
-	"pantone 346 u":           "#49bb89",
-	"pantone 347 c":           "#009844",
-	"pantone 347 u":           "#089860",
-	"pantone 348 c":           "#00833d",
-	"pantone 348 u":           "#2f835b",
-	"pantone 349 c":           "#016836",
-	"pantone 349 u":           "#457157",
-	"pantone 350 c":           "#2b5034",
-	"pantone 350 u":           "#536557",
-	"pantone 351 c":           "#a2e3b9",
-	"pantone 351 u":           "#8fe4b3",
-	"pantone 352 c":           "#8ce0b0",
-	"pantone 352 u":           "#6adea3",
-	"pantone 353 c":           "#7bdea7",
-	"pantone 353 u":           "#43d491",
-	"pantone 354 c":           "#00ae41",
-	"pantone 354 u":           "#00a75c",
-	"pantone 355 c":           "#00953a",
-	"pantone 355 u":           "#00955e",
-	"pantone 356 c":           "#007833",
-	"pantone 356 u":           "#347c59",
-	"pantone 357 c":           "#1c5631",
-	"pantone 357 u":           "#4e6e59",
-	"pantone 358 c":           "#acda90",
-	"pantone 358 u":           "#94d68a",
-	"pantone 359 c":           "#a2d683",
-	"pantone 359 u":           "#79ca77",
-	"pantone 360 c":           "#6cc049",
-	"pantone 360 u":           "#58b660",
-	"pantone 361 c":           "#3fae29",
-	"pantone 361 u":           "#48a84f",
-	"pantone 362 c":           "#4d9c2d",
-	"pantone 362 u":           "#53924f",
-	"pantone 363 c":           "#4b8b2a",
-	"pantone 363 u":           "#56834c",
-	"pantone 364 c":           "#497527",
-	"pantone 364 u":           "#5a784e",
-	"pantone 365 c":           "#c2df87",
-	"pantone 365 u":           "#c0e491",
-	"pantone 366 c":           "#b7dc78",
-	"pantone 366 u":           "#a6da7b",
-	"pantone 367 c":           "#a3d55d",
-	"pantone 367 u":           "#8dcf68",
-	"pantone 368 c":           "#77bc1f",
-	"pantone 368 u":           "#65b146",
-	"pantone 369 c":           "#62a60a",
-	"pantone 369 u":           "#609e44",
-	"pantone 370 c":           "#648b1a",
-	"pantone 370 u":           "#688848",
-	"pantone 371 c":           "#546121",
-	"pantone 371 u":           "#66714d",
-	"pantone 372 c":           "#d4eb8d",
-	"pantone 372 u":           "#d0ee8f",
-	"pantone 373 c":           "#cce97f",
-	"pantone 373 u":           "#b6e871",
-	"pantone 374 c":           "#c4e76a",
-	"pantone 374 u":           "#a1e15f",
-	"pantone 375 c":           "#94d500",
-	"pantone 375 u":           "#6bc82c",
-	"pantone 376 c":           "#82bc00",
-	"pantone 376 u":           "#6baa36",
-	"pantone 377 c":           "#799900",
-	"pantone 377 u":           "#6f8d41",
-	"pantone 378 c":           "#59611b",
-	"pantone 378 u":           "#727a4d",
-	"pantone 379 c":           "#e2e665",
-	"pantone 379 u":           "#e6ec78",
-	"pantone 380 c":           "#dbe341",
-	"pantone 380 u":           "#d3e257",
-	"pantone 381 c":           "#cedb00",
-	"pantone 381 u":           "#b8d537",
-	"pantone 382 c":           "#c3d500",
-	"pantone 382 u":           "#98c11e",
-	"pantone 383 c":           "#aaad00",
-	"pantone 383 u":           "#8d9935",
-	"pantone 384 c":           "#959300",
-	"pantone 384 u":           "#878a3b",
-	"pantone 385 c":           "#79701f",
-	"pantone 385 u":           "#7c794a",
-	"pantone 386 c":           "#eceb6b",
-	"pantone 386 u":           "#eef076",
-	"pantone 387 c":           "#e3e733",
-	"pantone 387 u":           "#e1ec52",
-	"pantone 388 c":           "#e1e61e",
-	"pantone 388 u":           "#d3e636",
-	"pantone 389 c":           "#cede00",
-	"pantone 389 u":           "#b9db00",
-	"pantone 390 c":           "#b6bd00",
-	"pantone 390 u":           "#94a824",
-	"pantone 391 c":           "#9a9400",
-	"pantone 391 u":           "#878a39",
-	"pantone 392 c":           "#837902",
-	"pantone 392 u":           "#7f7c40",
-	"pantone 393 c":           "#f1ec72",
-	"pantone 393 u":           "#f7f182",
-	"pantone 3935 c":          "#f5e85b",
-	"pantone 3935 u":          "#fdf287",
-	"pantone 394 c":           "#eee838",
-	"pantone 394 u":           "#f1ed55",
-	"pantone 3945 c":          "#f5e500",
-	"pantone 3945 u":          "#fdef5c",
-	"pantone 395 c":           "#ece813",
-	"pantone 395 u":           "#ebea3c",
-	"pantone 3955 c":          "#f2df00",
-	"pantone 3955 u":          "#faea33",
-	"pantone 396 c":           "#e1e000",
-	"pantone 396 u":           "#d8e200",
-	"pantone 3965 c":          "#eedc00",
-	"pantone 3965 u":          "#f8e709",
-	"pantone 397 c":           "#c0b800",
-	"pantone 397 u":           "#a7a92e",
-	"pantone 3975 c":          "#bea600",
-	"pantone 3975 u":          "#b7a62c",
-	"pantone 398 c":           "#ada300",
-	"pantone 398 u":           "#969437",
-	"pantone 3985 c":          "#9a8600",
-	"pantone 3985 u":          "#9c8f3f",
-	"pantone 399 c":           "#a29100",
-	"pantone 399 u":           "#8a8537",
-	"pantone 3995 c":          "#6a5c1f",
-	"pantone 3995 u":          "#827a4a",
-	"pantone 400 c":           "#c6beb5",
-	"pantone 400 u":           "#c7c0b9",
-	"pantone 401 c":           "#b1a99e",
-	"pantone 401 u":           "#b4ada6",
-	"pantone 402 c":           "#9e948b",
-	"pantone 402 u":           "#a29c97",
-	"pantone 403 c":           "#8d837a",
-	"pantone 403 u":           "#928c87",
-	"pantone 404 c":           "#776d63",
-	"pantone 404 u":           "#8b8580",
-	"pantone 405 c":           "#696057",
-	"pantone 405 u":           "#77716c",
-	"pantone 406 c":           "#c7bbb5",
-	"pantone 406 u":           "#c6beb9",
-	"pantone 407 c":           "#b2a7a1",
-	"pantone 407 u":           "#aba09e",
-	"pantone 408 c":           "#998b86",
-	"pantone 408 u":           "#9d9494",
-	"pantone 409 c":           "#877873",
-	"pantone 409 u":           "#948a88",
-	"pantone 410 c":           "#756660",
-	"pantone 410 u":           "#8a817f",
-	"pantone 411 c":           "#60504c",
-	"pantone 411 u":           "#7a716f",
-	"pantone 412 c":           "#392e2c",
-	"pantone 412 u":           "#645b59",
-	"pantone 413 c":           "#bbb9af",
-	"pantone 413 u":           "#bbbcb3",
-	"pantone 414 c":           "#aaa89e",
-	"pantone 414 u":           "#a9aba4",
-	"pantone 415 c":           "#929287",
-	"pantone 415 u":           "#9c9e99",
-	"pantone 416 c":           "#7f7e73",
-	"pantone 416 u":           "#8f918c",
-	"pantone 417 c":           "#66665c",
-	"pantone 417 u":           "#81837d",
-	"pantone 418 c":           "#525249",
-	"pantone 418 u":           "#787a75",
-	"pantone 419 c":           "#212221",
-	"pantone 419 u":           "#585753",
-	"pantone 420 c":           "#c9c8c7",
-	"pantone 420 u":           "#bdbdbc",
-	"pantone 421 c":           "#b2b3b2",
-	"pantone 421 u":           "#b2b4b4",
-	"pantone 422 c":           "#9fa1a1",
-	"pantone 422 u":           "#9ea0a2",
-	"pantone 423 c":           "#8a8b8c",
-	"pantone 423 u":           "#909295",
-	"pantone 424 c":           "#707170",
-	"pantone 424 u":           "#878889",
-	"pantone 425 c":           "#545759",
-	"pantone 425 u":           "#7a7c7e",
-	"pantone 426 c":           "#252729",
-	"pantone 426 u":           "#686768",
-	"pantone 427 c":           "#d0d2d3",
-	"pantone 427 u":           "#c2c7c9",
-	"pantone 428 c":           "#c3c5c8",
-	"pantone 428 u":           "#acb2b8",
-	"pantone 429 c":           "#a3a9ac",
-	"pantone 429 u":           "#90979e",
-	"pantone 430 c":           "#7c858c",
-	"pantone 430 u":           "#7e888f",
-	"pantone 431 c":           "#5c666f",
-	"pantone 431 u":           "#737a82",
-	"pantone 432 c":           "#333d47",
-	"pantone 432 u":           "#656b72",
-	"pantone 433 c":           "#1e242b",
-	"pantone 433 u":           "#5b5c62",
-	"pantone 434 c":           "#d0c4c4",
-	"pantone 434 u":           "#d3c9c9",
-	"pantone 435 c":           "#c0b2b5",
-	"pantone 435 u":           "#b3a8ac",
-	"pantone 436 c":           "#aa989c",
-	"pantone 436 u":           "#a89a9d",
-	"pantone 437 c":           "#7b6568",
-	"pantone 437 u":           "#8c7f81",
-	"pantone 438 c":           "#584444",
-	"pantone 438 u":           "#7f7273",
-	"pantone 439 c":           "#443634",
-	"pantone 439 u":           "#726767",
-	"pantone 440 c":           "#382d2b",
-	"pantone 440 u":           "#67605e",
-	"pantone 441 c":           "#bdc6c3",
-	"pantone 441 u":           "#b6c2c0",
-	"pantone 442 c":           "#a0acaa",
-	"pantone 442 u":           "#a9b5b5",
-	"pantone 443 c":           "#909c9c",
-	"pantone 443 u":           "#8c999a",
-	"pantone 444 c":           "#707b7b",
-	"pantone 444 u":           "#7c8688",
-	"pantone 445 c":           "#4e5758",
-	"pantone 445 u":           "#687171",
-	"pantone 446 c":           "#3d4542",
-	"pantone 446 u":           "#626867",
-	"pantone 447 c":           "#373935",
-	"pantone 447 u":           "#5d605f",
-	"pantone 448 c":           "#4c4028",
-	"pantone 448 u":           "#67604e",
-	"pantone 4485 c":          "#634e25",
-	"pantone 4485 u":          "#7b6e4b",
-	"pantone 449 c":           "#554525",
-	"pantone 449 u":           "#706a52",
-	"pantone 4495 c":          "#8e7630",
-	"pantone 4495 u":          "#91825e",
-	"pantone 450 c":           "#5c4925",
-	"pantone 450 u":           "#807659",
-	"pantone 4505 c":          "#9b8442",
-	"pantone 4505 u":          "#a39671",
-	"pantone 451 c":           "#9f915d",
-	"pantone 451 u":           "#939072",
-	"pantone 4515 c":          "#b5a268",
-	"pantone 4515 u":          "#b1a47e",
-	"pantone 452 c":           "#b3a77d",
-	"pantone 452 u":           "#a8a586",
-	"pantone 4525 c":          "#c7b683",
-	"pantone 4525 u":          "#c1b38b",
-	"pantone 453 c":           "#c3ba98",
-	"pantone 453 u":           "#bdb798",
-	"pantone 4535 c":          "#d1c392",
-	"pantone 4535 u":          "#cbbd94",
-	"pantone 454 c":           "#cdc4a6",
-	"pantone 454 u":           "#cbc6ab",
-	"pantone 4545 c":          "#d6ca9e",
-	"pantone 4545 u":          "#d6cba5",
-	"pantone 455 c":           "#6c5a23",
-	"pantone 455 u":           "#776e48",
-	"pantone 456 c":           "#a58d28",
-	"pantone 456 u":           "#918144",
-	"pantone 457 c":           "#ba9c13",
-	"pantone 457 u":           "#ad9641",
-	"pantone 458 c":           "#dbc554",
-	"pantone 458 u":           "#cfb863",
-	"pantone 459 c":           "#e0cc61",
-	"pantone 459 u":           "#dfca76",
-	"pantone 460 c":           "#e7d67e",
-	"pantone 460 u":           "#e9d789",
-	"pantone 461 c":           "#ecde97",
-	"pantone 461 u":           "#eee09d",
-	"pantone 462 c":           "#5e452a",
-	"pantone 462 u":           "#70614c",
-	"pantone 4625 c":          "#512b1c",
-	"pantone 4625 u":          "#715744",
-	"pantone 463 c":           "#774d27",
-	"pantone 463 u":           "#876d51",
-	"pantone 4635 c":          "#975f35",
-	"pantone 4635 u":          "#92705c",
-	"pantone 464 c":           "#8f5927",
-	"pantone 464 u":           "#9e7b56",
-	"pantone 4645 c":          "#b07c57",
-	"pantone 4645 u":          "#a17d6b",
-	"pantone 465 c":           "#bd955a",
-	"pantone 465 u":           "#b2926f",
-	"pantone 4655 c":          "#c19372",
-	"pantone 4655 u":          "#b28d7a",
-	"pantone 466 c":           "#caa976",
-	"pantone 466 u":           "#c4a480",
-	"pantone 4665 c":          "#cea787",
-	"pantone 4665 u":          "#caa48b",
-	"pantone 467 c":           "#d6ba8b",
-	"pantone 467 u":           "#d5b892",
-	"pantone 4675 c":          "#debfa4",
-	"pantone 4675 u":          "#dcb89f",
-	"pantone 468 c":           "#dfc9a2",
-	"pantone 468 u":           "#e1cda9",
-	"pantone 4685 c":          "#e1c5ac",
-	"pantone 4685 u":          "#e6cab3",
-	"pantone 469 c":           "#6b3f22",
-	"pantone 469 u":           "#7b5f4a",
-	"pantone 4695 c":          "#5d3326",
-	"pantone 4695 u":          "#775b4f",
-	"pantone 470 c":           "#a75928",
-	"pantone 470 u":           "#a56f51",
-	"pantone 4705 c":          "#814c3a",
-	"pantone 4705 u":          "#8b6e65",
-	"pantone 471 c":           "#bc6123",
-	"pantone 471 u":           "#c17a52",
-	"pantone 4715 c":          "#996a57",
-	"pantone 4715 u":          "#9b7c73",
-	"pantone 472 c":           "#e79d6c",
-	"pantone 472 u":           "#dd926f",
-	"pantone 4725 c":          "#b28877",
-	"pantone 4725 u":          "#b09087",
-	"pantone 473 c":           "#f5be99",
-	"pantone 473 u":           "#efab87",
-	"pantone 4735 c":          "#c3a291",
-	"pantone 4735 u":          "#bfa094",
-	"pantone 474 c":           "#f4c6a6",
-	"pantone 474 u":           "#f7bd9b",
-	"pantone 4745 c":          "#cfb4a7",
-	"pantone 4745 u":          "#d0b3a8",
-	"pantone 475 c":           "#f4cfb2",
-	"pantone 475 u":           "#fbcbab",
-	"pantone 4755 c":          "#dac2b6",
-	"pantone 4755 u":          "#ddc5bb",
-	"pantone 476 c":           "#513528",
-	"pantone 476 u":           "#6d5c50",
-	"pantone 477 c":           "#663a2a",
-	"pantone 477 u":           "#7e6355",
-	"pantone 478 c":           "#753d29",
-	"pantone 478 u":           "#906d5b",
-	"pantone 479 c":           "#ae7f65",
-	"pantone 479 u":           "#ae8a7d",
-	"pantone 480 c":           "#c9a791",
-	"pantone 480 u":           "#c5a292",
-	"pantone 481 c":           "#d6b9a7",
-	"pantone 481 u":           "#d6b5a6",
-	"pantone 482 c":           "#dec7b6",
-	"pantone 482 u":           "#e0c6b6",
-	"pantone 483 c":           "#682f24",
-	"pantone 483 u":           "#805a4e",
-	"pantone 484 c":           "#9f3122",
-	"pantone 484 u":           "#a35a4d",
-	"pantone 485 c":           "#e2231a",
-	"pantone 485 u":           "#ea5b4e",
-	"pantone 486 c":           "#ef8f7a",
-	"pantone 486 u":           "#f48a7f",
-	"pantone 487 c":           "#f0a693",
-	"pantone 487 u":           "#f7a192",
-	"pantone 488 c":           "#f1b8a8",
-	"pantone 488 u":           "#f7b7a7",
-	"pantone 489 c":           "#f0c2b2",
-	"pantone 489 u":           "#f8c6b7",
-	"pantone 490 c":           "#5e292a",
-	"pantone 490 u":           "#775552",
-	"pantone 491 c":           "#813033",
-	"pantone 491 u":           "#8f5958",
-	"pantone 492 c":           "#933235",
-	"pantone 492 u":           "#9d5e5e",
-	"pantone 493 c":           "#de8797",
-	"pantone 493 u":           "#c97c86",
-	"pantone 494 c":           "#eca2b0",
-	"pantone 494 u":           "#e59ba7",
-	"pantone 495 c":           "#f4bdc6",
-	"pantone 495 u":           "#f3afba",
-	"pantone 496 c":           "#f4c6ce",
-	"pantone 496 u":           "#fac2cb",
-	"pantone 497 c":           "#52302c",
-	"pantone 497 u":           "#705854",
-	"pantone 4975 c":          "#411f1f",
-	"pantone 4975 u":          "#735553",
-	"pantone 498 c":           "#6a3833",
-	"pantone 498 u":           "#805c5a",
-	"pantone 4985 c":          "#894b51",
-	"pantone 4985 u":          "#8f6a6d",
-	"pantone 499 c":           "#7a3f38",
-	"pantone 499 u":           "#8c615d",
-	"pantone 4995 c":          "#9d6167",
-	"pantone 4995 u":          "#a0787e",
-	"pantone 500 c":           "#c8868c",
-	"pantone 500 u":           "#bd8a8e",
-	"pantone 5005 c":          "#b27b80",
-	"pantone 5005 u":          "#b28a8f",
-	"pantone 501 c":           "#dca5ab",
-	"pantone 501 u":           "#d8a5a9",
-	"pantone 5015 c":          "#cda1a5",
-	"pantone 5015 u":          "#cba0a4",
-	"pantone 502 c":           "#e6bbc0",
-	"pantone 502 u":           "#e5b7bb",
-	"pantone 5025 c":          "#ddb7bb",
-	"pantone 5025 u":          "#dcb6ba",
-	"pantone 503 c":           "#ebc4c6",
-	"pantone 503 u":           "#f1cccd",
-	"pantone 5035 c":          "#e1c2c1",
-	"pantone 5035 u":          "#e6c6c6",
-	"pantone 504 c":           "#572930",
-	"pantone 504 u":           "#7f5c60",
-	"pantone 505 c":           "#702d3d",
-	"pantone 505 u":           "#8b5c64",
-	"pantone 506 c":           "#87354c",
-	"pantone 506 u":           "#9b6370",
-	"pantone 507 c":           "#d891a8",
-	"pantone 507 u":           "#cf8ea1",
-	"pantone 508 c":           "#e6a9b8",
-	"pantone 508 u":           "#e09cac",
-	"pantone 509 c":           "#ebb3c3",
-	"pantone 509 u":           "#ebaebd",
-	"pantone 510 c":           "#edbec8",
-	"pantone 510 u":           "#f3bfcb",
-	"pantone 511 c":           "#632c4f",
-	"pantone 511 u":           "#735567",
-	"pantone 5115 c":          "#532943",
-	"pantone 5115 u":          "#6f5462",
-	"pantone 512 c":           "#853175",
-	"pantone 512 u":           "#8a587e",
-	"pantone 5125 c":          "#6d3a5d",
-	"pantone 5125 u":          "#7e6276",
-	"pantone 513 c":           "#94348c",
-	"pantone 513 u":           "#9e5f97",
-	"pantone 5135 c":          "#815374",
-	"pantone 5135 u":          "#8d7086",
-	"pantone 514 c":           "#d68bc6",
-	"pantone 514 u":           "#d08ac1",
-	"pantone 5145 c":          "#9e7691",
-	"pantone 5145 u":          "#a5879e",
-	"pantone 515 c":           "#e4acd5",
-	"pantone 515 u":           "#e2a5d4",
-	"pantone 5155 c":          "#c1a4b7",
-	"pantone 5155 u":          "#b99db1",
-	"pantone 516 c":           "#e9bedb",
-	"pantone 516 u":           "#e8b6d9",
-	"pantone 5165 c":          "#d4bfcc",
-	"pantone 5165 u":          "#cdb3c3",
-	"pantone 517 c":           "#edc5de",
-	"pantone 517 u":           "#f3c8e1",
-	"pantone 5175 c":          "#dac6ce",
-	"pantone 5175 u":          "#dbc4cf",
-	"pantone 518 c":           "#4d2e47",
-	"pantone 518 u":           "#6d5968",
-	"pantone 5185 c":          "#4c3040",
-	"pantone 5185 u":          "#746068",
-	"pantone 519 c":           "#5b305d",
-	"pantone 519 u":           "#785d7b",
-	"pantone 5195 c":          "#684458",
-	"pantone 5195 u":          "#816b77",
-	"pantone 520 c":           "#662e6b",
-	"pantone 520 u":           "#825e86",
-	"pantone 5205 c":          "#886378",
-	"pantone 5205 u":          "#907b89",
-	"pantone 521 c":           "#a87eb1",
-	"pantone 521 u":           "#aa89b4",
-	"pantone 5215 c":          "#b095a5",
-	"pantone 5215 u":          "#a28c9a",
-	"pantone 522 c":           "#bd9bc4",
-	"pantone 522 u":           "#be9dc5",
-	"pantone 5225 c":          "#c8afbb",
-	"pantone 5225 u":          "#c3abb8",
-	"pantone 523 c":           "#ccb0cf",
-	"pantone 523 u":           "#d2b5d4",
-	"pantone 5235 c":          "#d2bdc6",
-	"pantone 5235 u":          "#d6c2ca",
-	"pantone 524 c":           "#d7c2d6",
-	"pantone 524 u":           "#dcc4dc",
-	"pantone 5245 c":          "#ddcdd2",
-	"pantone 5245 u":          "#dfcfd4",
-	"pantone 525 c":           "#592b5e",
-	"pantone 525 u":           "#735675",
-	"pantone 5255 c":          "#1e1933",
-	"pantone 5255 u":          "#5d566c",
-	"pantone 526 c":           "#742f8a",
-	"pantone 526 u":           "#815790",
-	"pantone 5265 c":          "#40395f",
-	"pantone 5265 u":          "#6e6a81",
-	"pantone 527 c":           "#8430a6",
-	"pantone 527 u":           "#945fae",
-	"pantone 5275 c":          "#5a5377",
-	"pantone 5275 u":          "#797891",
-	"pantone 528 c":           "#ba80d0",
-	"pantone 528 u":           "#ba88d2",
-	"pantone 5285 c":          "#8e88a3",
-	"pantone 5285 u":          "#9593ab",
-	"pantone 529 c":           "#cea1dc",
-	"pantone 529 u":           "#cfa3e0",
-	"pantone 5295 c":          "#b4afc3",
-	"pantone 5295 u":          "#b1adc3",
-	"pantone 530 c":           "#dab7e2",
-	"pantone 530 u":           "#dcb8e7",
-	"pantone 5305 c":          "#c7c3d1",
-	"pantone 5305 u":          "#c4c1d1",
-	"pantone 531 c":           "#e2c7e6",
-	"pantone 531 u":           "#e4c6ea",
-	"pantone 5315 c":          "#dad6de",
-	"pantone 5315 u":          "#dad7e1",
-	"pantone 532 c":           "#1c1e29",
-	"pantone 532 u":           "#5b5a62",
-	"pantone 533 c":           "#212844",
-	"pantone 533 u":           "#5e6378",
-	"pantone 534 c":           "#1e345d",
-	"pantone 534 u":           "#5a6783",
-	"pantone 535 c":           "#8e9ebc",
-	"pantone 535 u":           "#8b9cb8",
-	"pantone 536 c":           "#a2b1c8",
-	"pantone 536 u":           "#a2b4ca",
-	"pantone 537 c":           "#bbc6d6",
-	"pantone 537 u":           "#bbc9d9",
-	"pantone 538 c":           "#c6cfda",
-	"pantone 538 u":           "#ccd5e0",
-	"pantone 539 c":           "#002539",
-	"pantone 539 u":           "#455063",
-	"pantone 5395 c":          "#0a1e2c",
-	"pantone 5395 u":          "#464a58",
-	"pantone 540 c":           "#002f56",
-	"pantone 540 u":           "#3f506e",
-	"pantone 5405 c":          "#4f738a",
-	"pantone 5405 u":          "#596e80",
-	"pantone 541 c":           "#003b70",
-	"pantone 541 u":           "#38547a",
-	"pantone 5415 c":          "#5d7e95",
-	"pantone 5415 u":          "#657d91",
-	"pantone 542 c":           "#7cadd3",
-	"pantone 542 u":           "#679ac6",
-	"pantone 5425 c":          "#7c97ab",
-	"pantone 5425 u":          "#7891a4",
-	"pantone 543 c":           "#a4c7e2",
-	"pantone 543 u":           "#78a9d2",
-	"pantone 5435 c":          "#a7bac8",
-	"pantone 5435 u":          "#9cb3c4",
-	"pantone 544 c":           "#bfd4e6",
-	"pantone 544 u":           "#96bfde",
-	"pantone 5445 c":          "#b8c7d3",
-	"pantone 5445 u":          "#b3c6d2",
-	"pantone 545 c":           "#c7d9e6",
-	"pantone 545 u":           "#accde5",
-	"pantone 5455 c":          "#bfcdd4",
-	"pantone 5455 u":          "#c7d5df",
-	"pantone 546 c":           "#08292f",
-	"pantone 546 u":           "#444e57",
-	"pantone 5463 c":          "#08262c",
-	"pantone 5463 u":          "#3d4d56",
-	"pantone 5467 c":          "#1a322e",
-	"pantone 5467 u":          "#535e5e",
-	"pantone 547 c":           "#002f3b",
-	"pantone 547 u":           "#415766",
-	"pantone 5473 c":          "#095c66",
-	"pantone 5473 u":          "#4f6c75",
-	"pantone 5477 c":          "#3f5b57",
-	"pantone 5477 u":          "#5e6f6f",
-	"pantone 548 c":           "#003b4c",
-	"pantone 548 u":           "#3e5c6f",
-	"pantone 5483 c":          "#4d858d",
-	"pantone 5483 u":          "#5c8088",
-	"pantone 5487 c":          "#5f7774",
-	"pantone 5487 u":          "#687b7d",
-	"pantone 549 c":           "#6aa2b8",
-	"pantone 549 u":           "#6797ad",
-	"pantone 5493 c":          "#7ea7ac",
-	"pantone 5493 u":          "#739aa2",
-	"pantone 5497 c":          "#849794",
-	"pantone 5497 u":          "#819393",
-	"pantone 550 c":           "#8db7c9",
-	"pantone 550 u":           "#78a8be",
-	"pantone 5503 c":          "#93b6bb",
-	"pantone 5503 u":          "#91b5bb",
-	"pantone 5507 c":          "#9daeab",
-	"pantone 5507 u":          "#96a7a6",
-	"pantone 551 c":           "#a4c5d2",
-	"pantone 551 u":           "#95becd",
-	"pantone 5513 c":          "#acc6c8",
-	"pantone 5513 u":          "#abc8cc",
-	"pantone 5517 c":          "#b2bfbb",
-	"pantone 5517 u":          "#a7b8b7",
-	"pantone 552 c":           "#bbd2db",
-	"pantone 552 u":           "#aecddb",
-	"pantone 5523 c":          "#b7cecf",
-	"pantone 5523 u":          "#bbd4d5",
-	"pantone 5527 c":          "#bcc7c4",
-	"pantone 5527 u":          "#b7c6c3",
-	"pantone 553 c":           "#2a4633",
-	"pantone 553 u":           "#59675c",
-	"pantone 5535 c":          "#172f28",
-	"pantone 5535 u":          "#475855",
-	"pantone 554 c":           "#225a40",
-	"pantone 554 u":           "#567465",
-	"pantone 5545 c":          "#43685a",
-	"pantone 5545 u":          "#5f756f",
-	"pantone 555 c":           "#2c704f",
-	"pantone 555 u":           "#557c6a",
-	"pantone 5555 c":          "#5b7f70",
-	"pantone 5555 u":          "#738c86",
-	"pantone 556 c":           "#70a087",
-	"pantone 556 u":           "#719b8b",
-	"pantone 5565 c":          "#7e9c90",
-	"pantone 5565 u":          "#87a19a",
-	"pantone 557 c":           "#87af99",
-	"pantone 557 u":           "#88b09e",
-	"pantone 5575 c":          "#92aba0",
-	"pantone 5575 u":          "#9bb3aa",
-	"pantone 558 c":           "#9bbdaa",
-	"pantone 558 u":           "#99bead",
-	"pantone 5585 c":          "#a6bcb0",
-	"pantone 5585 u":          "#a1b8af",
-	"pantone 559 c":           "#afc9b8",
-	"pantone 559 u":           "#b5d0c0",
-	"pantone 5595 c":          "#becdc2",
-	"pantone 5595 u":          "#bfd1c7",
-	"pantone 560 c":           "#1b3c33",
-	"pantone 560 u":           "#495d5b",
-	"pantone 5605 c":          "#22362a",
-	"pantone 5605 u":          "#5e685e",
-	"pantone 561 c":           "#00584d",
-	"pantone 561 u":           "#446c68",
-	"pantone 5615 c":          "#5e7260",
-	"pantone 5615 u":          "#6a776f",
-	"pantone 562 c":           "#006e62",
-	"pantone 562 u":           "#3e7f7b",
-	"pantone 5625 c":          "#728472",
-	"pantone 5625 u":          "#79877f",
-	"pantone 563 c":           "#67baaf",
-	"pantone 563 u":           "#71b8b0",
-	"pantone 5635 c":          "#95a495",
-	"pantone 5635 u":          "#8d9c94",
-	"pantone 564 c":           "#82c7bc",
-	"pantone 564 u":           "#82c5bb",
-	"pantone 5645 c":          "#a4b1a3",
-	"pantone 5645 u":          "#9faea5",
-	"pantone 565 c":           "#9fd4c9",
-	"pantone 565 u":           "#8dd0c4",
-	"pantone 5655 c":          "#b2bcaf",
-	"pantone 5655 u":          "#b3beb4",
-	"pantone 566 c":           "#b7dbd1",
-	"pantone 566 u":           "#b0e0d6",
-	"pantone 5665 c":          "#bcc4b9",
-	"pantone 5665 u":          "#bac6bc",
-	"pantone 567 c":           "#163d34",
-	"pantone 567 u":           "#49625d",
-	"pantone 568 c":           "#006a5a",
-	"pantone 568 u":           "#427f76",
-	"pantone 569 c":           "#007f6d",
-	"pantone 569 u":           "#238d81",
-	"pantone 570 c":           "#69c9b9",
-	"pantone 570 u":           "#5bc1b3",
-	"pantone 571 c":           "#94dace",
-	"pantone 571 u":           "#80d6c7",
-	"pantone 572 c":           "#a2ded2",
-	"pantone 572 u":           "#90dccf",
-	"pantone 573 c":           "#b4e1d8",
-	"pantone 573 u":           "#b8e8de",
-	"pantone 574 c":           "#4e5a30",
-	"pantone 574 u":           "#697155",
-	"pantone 5743 c":          "#404726",
-	"pantone 5743 u":          "#636951",
-	"pantone 5747 c":          "#3e431c",
-	"pantone 5747 u":          "#6f734d",
-	"pantone 575 c":           "#69813a",
-	"pantone 575 u":           "#6f8256",
-	"pantone 5753 c":          "#606637",
-	"pantone 5753 u":          "#747b60",
-	"pantone 5757 c":          "#6c702d",
-	"pantone 5757 u":          "#80845b",
-	"pantone 576 c":           "#7a9b49",
-	"pantone 576 u":           "#7b9961",
-	"pantone 5763 c":          "#767a4c",
-	"pantone 5763 u":          "#7d856c",
-	"pantone 5767 c":          "#8c8c48",
-	"pantone 5767 u":          "#93966b",
-	"pantone 577 c":           "#abc27e",
-	"pantone 577 u":           "#93b279",
-	"pantone 5773 c":          "#8a8f64",
-	"pantone 5773 u":          "#98a084",
-	"pantone 5777 c":          "#a2a467",
-	"pantone 5777 u":          "#a5a87a",
-	"pantone 578 c":           "#b8cd95",
-	"pantone 578 u":           "#a7c28d",
-	"pantone 5783 c":          "#a4a982",
-	"pantone 5783 u":          "#b4b99e",
-	"pantone 5787 c":          "#babc89",
-	"pantone 5787 u":          "#babd90",
-	"pantone 579 c":           "#bfcf9a",
-	"pantone 579 u":           "#b9cf9d",
-	"pantone 5793 c":          "#b4b794",
-	"pantone 5793 u":          "#c5c8ae",
-	"pantone 5797 c":          "#c7c79a",
-	"pantone 5797 u":          "#c6c99b",
-	"pantone 580 c":           "#c5d4a3",
-	"pantone 580 u":           "#cadaac",
-	"pantone 5803 c":          "#c4c6a6",
-	"pantone 5803 u":          "#d0d1b8",
-	"pantone 5807 c":          "#d1d0a9",
-	"pantone 5807 u":          "#d1d2a9",
-	"pantone 581 c":           "#645c20",
-	"pantone 581 u":           "#787449",
-	"pantone 5815 c":          "#564f23",
-	"pantone 5815 u":          "#726e4a",
-	"pantone 582 c":           "#918c10",
-	"pantone 582 u":           "#919044",
-	"pantone 5825 c":          "#8b803a",
-	"pantone 5825 u":          "#8a855c",
-	"pantone 583 c":           "#b9be10",
-	"pantone 583 u":           "#a5b13e",
-	"pantone 5835 c":          "#a19856",
-	"pantone 5835 u":          "#948e65",
-	"pantone 584 c":           "#d5d654",
-	"pantone 584 u":           "#ced564",
-	"pantone 5845 c":          "#b1a96e",
-	"pantone 5845 u":          "#a7a274",
-	"pantone 585 c":           "#dedd6e",
-	"pantone 585 u":           "#e3e584",
-	"pantone 5855 c":          "#c1ba85",
-	"pantone 5855 u":          "#bcb788",
-	"pantone 586 c":           "#e3e17b",
-	"pantone 586 u":           "#e6e786",
-	"pantone 5865 c":          "#ccc693",
-	"pantone 5865 u":          "#cec794",
-	"pantone 587 c":           "#e5e38c",
-	"pantone 587 u":           "#eceb97",
-	"pantone 5875 c":          "#d5cd9e",
-	"pantone 5875 u":          "#d7d19f",
-	"pantone 600 c":           "#f2ea9a",
-	"pantone 600 u":           "#faf19b",
-	"pantone 601 c":           "#f2e88e",
-	"pantone 601 u":           "#f7ee8d",
-	"pantone 602 c":           "#f2e77a",
-	"pantone 602 u":           "#f7ec81",
-	"pantone 603 c":           "#efe048",
-	"pantone 603 u":           "#f4e461",
-	"pantone 604 c":           "#edda22",
-	"pantone 604 u":           "#eeda4c",
-	"pantone 605 c":           "#e2cc00",
-	"pantone 605 u":           "#e1cb38",
-	"pantone 606 c":           "#d1b400",
-	"pantone 606 u":           "#cfb724",
-	"pantone 607 c":           "#ede499",
-	"pantone 607 u":           "#f3e9a1",
-	"pantone 608 c":           "#eae085",
-	"pantone 608 u":           "#f0e590",
-	"pantone 609 c":           "#e9dc75",
-	"pantone 609 u":           "#ebde80",
-	"pantone 610 c":           "#e2d354",
-	"pantone 610 u":           "#dfd166",
-	"pantone 611 c":           "#d8c722",
-	"pantone 611 u":           "#c8b84e",
-	"pantone 612 c":           "#c7b000",
-	"pantone 612 u":           "#b7a63e",
-	"pantone 613 c":           "#b59a00",
-	"pantone 613 u":           "#a29335",
-	"pantone 614 c":           "#ddd498",
-	"pantone 614 u":           "#e2d8a1",
-	"pantone 615 c":           "#d7cd8c",
-	"pantone 615 u":           "#dacf94",
-	"pantone 616 c":           "#d2c780",
-	"pantone 616 u":           "#cec387",
-	"pantone 617 c":           "#c1b45f",
-	"pantone 617 u":           "#bcb174",
-	"pantone 618 c":           "#ad9d3b",
-	"pantone 618 u":           "#a69c62",
-	"pantone 619 c":           "#a09028",
-	"pantone 619 u":           "#968c53",
-	"pantone 620 c":           "#8c7a16",
-	"pantone 620 u":           "#877e48",
-	"pantone 621 c":           "#d0e0d7",
-	"pantone 621 u":           "#c7ded3",
-	"pantone 622 c":           "#b8ccc2",
-	"pantone 622 u":           "#b0cbc1",
-	"pantone 623 c":           "#9ab8ac",
-	"pantone 623 u":           "#95b4aa",
-	"pantone 624 c":           "#789d90",
-	"pantone 624 u":           "#809f97",
-	"pantone 625 c":           "#4f7e70",
-	"pantone 625 u":           "#698882",
-	"pantone 626 c":           "#255b4d",
-	"pantone 626 u":           "#58746e",
-	"pantone 627 c":           "#12312a",
-	"pantone 627 u":           "#425b57",
-	"pantone 628 c":           "#b8dce1",
-	"pantone 628 u":           "#b3dee6",
-	"pantone 629 c":           "#99d1dc",
-	"pantone 629 u":           "#8acedd",
-	"pantone 630 c":           "#76c3d4",
-	"pantone 630 u":           "#72c2d7",
-	"pantone 631 c":           "#3ab0c8",
-	"pantone 631 u":           "#4fb0cb",
-	"pantone 632 c":           "#0091b2",
-	"pantone 632 u":           "#3096b4",
-	"pantone 633 c":           "#007197",
-	"pantone 633 u":           "#21809e",
-	"pantone 634 c":           "#005c82",
-	"pantone 634 u":           "#1a6c8a",
-	"pantone 635 c":           "#a3d9e7",
-	"pantone 635 u":           "#a9e1ee",
-	"pantone 636 c":           "#89d2e6",
-	"pantone 636 u":           "#85d7ee",
-	"pantone 637 c":           "#4dc1df",
-	"pantone 637 u":           "#58c6e5",
-	"pantone 638 c":           "#00acd7",
-	"pantone 638 u":           "#28b5de",
-	"pantone 639 c":           "#0093c9",
-	"pantone 639 u":           "#009bcd",
-	"pantone 640 c":           "#0080bb",
-	"pantone 640 u":           "#0088b9",
-	"pantone 641 c":           "#0065a0",
-	"pantone 641 u":           "#0076a6",
-	"pantone 642 c":           "#d4dce5",
-	"pantone 642 u":           "#d1deea",
-	"pantone 643 c":           "#c7d4e2",
-	"pantone 643 u":           "#bfd1e4",
-	"pantone 644 c":           "#9cb6d2",
-	"pantone 644 u":           "#9db7d3",
-	"pantone 645 c":           "#7da0c3",
-	"pantone 645 u":           "#83a1c4",
-	"pantone 646 c":           "#5d89b3",
-	"pantone 646 u":           "#6c8baf",
-	"pantone 647 c":           "#265f92",
-	"pantone 647 u":           "#5c799c",
-	"pantone 648 c":           "#002c5c",
-	"pantone 648 u":           "#4e6080",
-	"pantone 649 c":           "#dce1e9",
-	"pantone 649 u":           "#dee6ed",
-	"pantone 650 c":           "#cfd9e5",
-	"pantone 650 u":           "#d6dfea",
-	"pantone 651 c":           "#a8bbd5",
-	"pantone 651 u":           "#afc2dc",
-	"pantone 652 c":           "#7e9abf",
-	"pantone 652 u":           "#89a1c3",
-	"pantone 653 c":           "#346094",
-	"pantone 653 u":           "#687fa4",
-	"pantone 654 c":           "#00396f",
-	"pantone 654 u":           "#53688c",
-	"pantone 655 c":           "#002453",
-	"pantone 655 u":           "#4d5b7e",
-	"pantone 656 c":           "#dee3eb",
-	"pantone 656 u":           "#e1e9f2",
-	"pantone 657 c":           "#cad7eb",
-	"pantone 657 u":           "#d5e0f1",
-	"pantone 658 c":           "#b2c8e8",
-	"pantone 658 u":           "#b8ceee",
-	"pantone 659 c":           "#7ba3dc",
-	"pantone 659 u":           "#94b4e6",
-	"pantone 660 c":           "#407cc9",
-	"pantone 660 u":           "#6289ca",
-	"pantone 661 c":           "#003494",
-	"pantone 661 u":           "#4666aa",
-	"pantone 662 c":           "#001b70",
-	"pantone 662 u":           "#415290",
-	"pantone 663 c":           "#e6e1e5",
-	"pantone 663 u":           "#e1d8e1",
-	"pantone 664 c":           "#e1dae1",
-	"pantone 664 u":           "#d9cfdc",
-	"pantone 665 c":           "#c8bbcf",
-	"pantone 665 u":           "#c4b8ce",
-	"pantone 666 c":           "#a391b1",
-	"pantone 666 u":           "#aea0bc",
-	"pantone 667 c":           "#7d6890",
-	"pantone 667 u":           "#8f829f",
-	"pantone 668 c":           "#634b77",
-	"pantone 668 u":           "#82728f",
-	"pantone 669 c":           "#3f2a55",
-	"pantone 669 u":           "#71627e",
-	"pantone 670 c":           "#edd2e1",
-	"pantone 670 u":           "#f4d4e4",
-	"pantone 671 c":           "#e9bbd7",
-	"pantone 671 u":           "#f2c2dd",
-	"pantone 672 c":           "#e39fc8",
-	"pantone 672 u":           "#eca6cc",
-	"pantone 673 c":           "#df84ba",
-	"pantone 673 u":           "#e38bba",
-	"pantone 674 c":           "#cd5599",
-	"pantone 674 u":           "#d1709f",
-	"pantone 675 c":           "#b42371",
-	"pantone 675 u":           "#c05f8d",
-	"pantone 676 c":           "#9b004e",
-	"pantone 676 u":           "#ac4f77",
-	"pantone 677 c":           "#e8cddb",
-	"pantone 677 u":           "#eed0e0",
-	"pantone 678 c":           "#e7c7d7",
-	"pantone 678 u":           "#e8bfd3",
-	"pantone 679 c":           "#e0bdd2",
-	"pantone 679 u":           "#dcabc6",
-	"pantone 680 c":           "#cd94b3",
-	"pantone 680 u":           "#c28aa7",
-	"pantone 681 c":           "#b46a94",
-	"pantone 681 u":           "#a86e8c",
-	"pantone 682 c":           "#9d4777",
-	"pantone 682 u":           "#915c76",
-	"pantone 683 c":           "#7f2754",
-	"pantone 683 u":           "#825166",
-	"pantone 684 c":           "#e5c5d3",
-	"pantone 684 u":           "#eccbd9",
-	"pantone 685 c":           "#deb5c8",
-	"pantone 685 u":           "#e4bdcf",
-	"pantone 686 c":           "#d39fb7",
-	"pantone 686 u":           "#d6a6bd",
-	"pantone 687 c":           "#c184a3",
-	"pantone 687 u":           "#be8ca5",
-	"pantone 688 c":           "#ab6388",
-	"pantone 688 u":           "#a3728b",
-	"pantone 689 c":           "#8c3b66",
-	"pantone 689 u":           "#8e6176",
-	"pantone 690 c":           "#64223f",
-	"pantone 690 u":           "#7d4f5f",
-	"pantone 691 c":           "#ecccce",
-	"pantone 691 u":           "#f1d2d3",
-	"pantone 692 c":           "#e6bec2",
-	"pantone 692 u":           "#eabfc4",
-	"pantone 693 c":           "#dba2aa",
-	"pantone 693 u":           "#dba4ab",
-	"pantone 694 c":           "#c7848f",
-	"pantone 694 u":           "#c48c94",
-	"pantone 695 c":           "#b86b78",
-	"pantone 695 u":           "#ad757e",
-	"pantone 696 c":           "#9b4755",
-	"pantone 696 u":           "#99646b",
-	"pantone 697 c":           "#8c3b44",
-	"pantone 697 u":           "#87555a",
-	"pantone 698 c":           "#f5d3d6",
-	"pantone 698 u":           "#fbd6d9",
-	"pantone 699 c":           "#f7c2ca",
-	"pantone 699 u":           "#fec0c9",
-	"pantone 700 c":           "#f7acb8",
-	"pantone 700 u":           "#f9a9b5",
-	"pantone 701 c":           "#ea8597",
-	"pantone 701 u":           "#ec8e9d",
-	"pantone 702 c":           "#d75c71",
-	"pantone 702 u":           "#db7886",
-	"pantone 703 c":           "#bd3a49",
-	"pantone 703 u":           "#b65a64",
-	"pantone 704 c":           "#a2292e",
-	"pantone 704 u":           "#a54f56",
-	"pantone 705 c":           "#f8dadf",
-	"pantone 705 u":           "#ffdce0",
-	"pantone 706 c":           "#f8cdd6",
-	"pantone 706 u":           "#ffc9d2",
-	"pantone 707 c":           "#fbb5c3",
-	"pantone 707 u":           "#ffb0be",
-	"pantone 708 c":           "#fc91a2",
-	"pantone 708 u":           "#ff97a6",
-	"pantone 709 c":           "#f46177",
-	"pantone 709 u":           "#f87b88",
-	"pantone 710 c":           "#e73d50",
-	"pantone 710 u":           "#e9636e",
-	"pantone 711 c":           "#d12a2e",
-	"pantone 711 u":           "#d24f53",
-	"pantone 712 c":           "#ffc999",
-	"pantone 712 u":           "#ffca9c",
-	"pantone 713 c":           "#ffbe84",
-	"pantone 713 u":           "#ffc18f",
-	"pantone 714 c":           "#ffa960",
-	"pantone 714 u":           "#ffb078",
-	"pantone 715 c":           "#f98d29",
-	"pantone 715 u":           "#fb9a61",
-	"pantone 716 c":           "#ed7700",
-	"pantone 716 u":           "#eb854f",
-	"pantone 717 c":           "#d75e00",
-	"pantone 717 u":           "#da763e",
-	"pantone 718 c":           "#c14d00",
-	"pantone 718 u":           "#c96d3c",
-	"pantone 719 c":           "#eec7a1",
-	"pantone 719 u":           "#f2c5a1",
-	"pantone 720 c":           "#e9b789",
-	"pantone 720 u":           "#edba92",
-	"pantone 721 c":           "#dfa36c",
-	"pantone 721 u":           "#dfa77e",
-	"pantone 722 c":           "#cb8241",
-	"pantone 722 u":           "#cb916a",
-	"pantone 723 c":           "#b46a1f",
-	"pantone 723 u":           "#b9825e",
-	"pantone 724 c":           "#954e0e",
-	"pantone 724 u":           "#a6734e",
-	"pantone 725 c":           "#7f4014",
-	"pantone 725 u":           "#966746",
-	"pantone 726 c":           "#e2c19d",
-	"pantone 726 u":           "#e8c5a8",
-	"pantone 727 c":           "#dcb38c",
-	"pantone 727 u":           "#dcb495",
-	"pantone 728 c":           "#cfa075",
-	"pantone 728 u":           "#c9a081",
-	"pantone 729 c":           "#b7814f",
-	"pantone 729 u":           "#bd9274",
-	"pantone 730 c":           "#a0662c",
-	"pantone 730 u":           "#a47d61",
-	"pantone 731 c":           "#79420f",
-	"pantone 731 u":           "#967155",
-	"pantone 732 c":           "#64340f",
-	"pantone 732 u":           "#89674c",
-	"pantone 7401 c":          "#f8e0a4",
-	"pantone 7401 u":          "#ffe3a4",
-	"pantone 7402 c":          "#eed797",
-	"pantone 7402 u":          "#f5d999",
-	"pantone 7403 c":          "#f1d282",
-	"pantone 7403 u":          "#f6ce81",
-	"pantone 7404 c":          "#f7d93d",
-	"pantone 7404 u":          "#ffcd3f",
-	"pantone 7405 c":          "#f3cd00",
-	"pantone 7405 u":          "#e7b107",
-	"pantone 7406 c":          "#f4c300",
-	"pantone 7406 u":          "#f2b726",
-	"pantone 7407 c":          "#ce9f51",
-	"pantone 7407 u":          "#cea36e",
-	"pantone 7408 c":          "#f9be00",
-	"pantone 7408 u":          "#f09d23",
-	"pantone 7409 c":          "#f6b221",
-	"pantone 7409 u":          "#fbac4e",
-	"pantone 7410 c":          "#ffae75",
-	"pantone 7410 u":          "#ffa06d",
-	"pantone 7411 c":          "#eba55d",
-	"pantone 7411 u":          "#eca06f",
-	"pantone 7412 c":          "#d68230",
-	"pantone 7412 u":          "#d18b5e",
-	"pantone 7413 c":          "#e18431",
-	"pantone 7413 u":          "#e28758",
-	"pantone 7414 c":          "#c46c13",
-	"pantone 7414 u":          "#c27e51",
-	"pantone 7415 c":          "#eab8a6",
-	"pantone 7415 u":          "#eeb3a4",
-	"pantone 7416 c":          "#eb6851",
-	"pantone 7416 u":          "#f0716a",
-	"pantone 7417 c":          "#e84a37",
-	"pantone 7417 u":          "#ed6960",
-	"pantone 7418 c":          "#d15458",
-	"pantone 7418 u":          "#c16364",
-	"pantone 7419 c":          "#b24b58",
-	"pantone 7419 u":          "#a66269",
-	"pantone 7420 c":          "#a02040",
-	"pantone 7420 u":          "#a75767",
-	"pantone 7421 c":          "#6a1a31",
-	"pantone 7421 u":          "#7e4d5a",
-	"pantone 7422 c":          "#f6cdd3",
-	"pantone 7422 u":          "#fdd4d8",
-	"pantone 7423 c":          "#e46285",
-	"pantone 7423 u":          "#e7758d",
-	"pantone 7424 c":          "#e74582",
-	"pantone 7424 u":          "#dc567a",
-	"pantone 7425 c":          "#ba2253",
-	"pantone 7425 u":          "#be5a72",
-	"pantone 7426 c":          "#a80f3d",
-	"pantone 7426 u":          "#b55469",
-	"pantone 7427 c":          "#9d162e",
-	"pantone 7427 u":          "#a9515c",
-	"pantone 7428 c":          "#6d2a3c",
-	"pantone 7428 u":          "#815b65",
-	"pantone 7429 c":          "#e5bac9",
-	"pantone 7429 u":          "#eecbd8",
-	"pantone 7430 c":          "#e0a8be",
-	"pantone 7430 u":          "#e8b4c8",
-	"pantone 7431 c":          "#ce7e9c",
-	"pantone 7431 u":          "#dfa1b8",
-	"pantone 7432 c":          "#bb5a7f",
-	"pantone 7432 u":          "#c78099",
-	"pantone 7433 c":          "#ad3863",
-	"pantone 7433 u":          "#bb6e87",
-	"pantone 7434 c":          "#9f3058",
-	"pantone 7434 u":          "#ab667b",
-	"pantone 7435 c":          "#8a244e",
-	"pantone 7435 u":          "#8e556a",
-	"pantone 7436 c":          "#f2dae9",
-	"pantone 7436 u":          "#f4d4ec",
-	"pantone 7437 c":          "#cfacce",
-	"pantone 7437 u":          "#d6b4d4",
-	"pantone 7438 c":          "#d89dd7",
-	"pantone 7438 u":          "#da9ad6",
-	"pantone 7439 c":          "#b687b8",
-	"pantone 7439 u":          "#c19cc5",
-	"pantone 7440 c":          "#a776a5",
-	"pantone 7440 u":          "#b894b8",
-	"pantone 7441 c":          "#a25cbf",
-	"pantone 7441 u":          "#b07dc9",
-	"pantone 7442 c":          "#9a3cbb",
-	"pantone 7442 u":          "#9e62bb",
-	"pantone 7443 c":          "#dedae8",
-	"pantone 7443 u":          "#ddd7ea",
-	"pantone 7444 c":          "#b7b8dc",
-	"pantone 7444 u":          "#bcbce1",
-	"pantone 7445 c":          "#a8a1c1",
-	"pantone 7445 u":          "#aca7c6",
-	"pantone 7446 c":          "#8c85c9",
-	"pantone 7446 u":          "#9491cf",
-	"pantone 7447 c":          "#604775",
-	"pantone 7447 u":          "#7e7292",
-	"pantone 7448 c":          "#4c374b",
-	"pantone 7448 u":          "#726774",
-	"pantone 7449 c":          "#43263a",
-	"pantone 7449 u":          "#63505b",
-	"pantone 7450 c":          "#bec3d9",
-	"pantone 7450 u":          "#c2c9df",
-	"pantone 7451 c":          "#89a9e2",
-	"pantone 7451 u":          "#93b1e9",
-	"pantone 7452 c":          "#8193dc",
-	"pantone 7452 u":          "#8fa0e4",
-	"pantone 7453 c":          "#7ca4dd",
-	"pantone 7453 u":          "#85abe3",
-	"pantone 7454 c":          "#618db4",
-	"pantone 7454 u":          "#7597bb",
-	"pantone 7455 c":          "#3a5cac",
-	"pantone 7455 u":          "#677cb8",
-	"pantone 7456 c":          "#606db2",
-	"pantone 7456 u":          "#7f88be",
-	"pantone 7457 c":          "#bbdbe5",
-	"pantone 7457 u":          "#bde1ec",
-	"pantone 7458 c":          "#71b1c8",
-	"pantone 7458 u":          "#65a4be",
-	"pantone 7459 c":          "#4196b4",
-	"pantone 7459 u":          "#4f8ca9",
-	"pantone 7460 c":          "#0083c1",
-	"pantone 7460 u":          "#008bc1",
-	"pantone 7461 c":          "#007cba",
-	"pantone 7461 u":          "#3e90c6",
-	"pantone 7462 c":          "#00538a",
-	"pantone 7462 u":          "#5479a2",
-	"pantone 7463 c":          "#002a48",
-	"pantone 7463 u":          "#50647d",
-	"pantone 7464 c":          "#a1cfca",
-	"pantone 7464 u":          "#a9d9d7",
-	"pantone 7465 c":          "#3ebfac",
-	"pantone 7465 u":          "#4dc4b5",
-	"pantone 7466 c":          "#00adbb",
-	"pantone 7466 u":          "#00b1bd",
-	"pantone 7467 c":          "#00a0ae",
-	"pantone 7467 u":          "#00a5b3",
-	"pantone 7468 c":          "#007298",
-	"pantone 7468 u":          "#3c7594",
-	"pantone 7469 c":          "#005d85",
-	"pantone 7469 u":          "#376c89",
-	"pantone 7470 c":          "#00576f",
-	"pantone 7470 u":          "#426f83",
-	"pantone 7471 c":          "#7ddbd3",
-	"pantone 7471 u":          "#79e0da",
-	"pantone 7472 c":          "#5ab6b2",
-	"pantone 7472 u":          "#67bcb8",
-	"pantone 7473 c":          "#1e988a",
-	"pantone 7473 u":          "#5ba39a",
-	"pantone 7474 c":          "#007480",
-	"pantone 7474 u":          "#3e8994",
-	"pantone 7475 c":          "#46797a",
-	"pantone 7475 u":          "#68898c",
-	"pantone 7476 c":          "#075056",
-	"pantone 7476 u":          "#57767b",
-	"pantone 7477 c":          "#264b59",
-	"pantone 7477 u":          "#5d6f78",
-	"pantone 7478 c":          "#97e2be",
-	"pantone 7478 u":          "#9be9c5",
-	"pantone 7479 c":          "#05ce7b",
-	"pantone 7479 u":          "#01c97f",
-	"pantone 7480 c":          "#00bc6f",
-	"pantone 7480 u":          "#00bf81",
-	"pantone 7481 c":          "#00b44f",
-	"pantone 7481 u":          "#00b76d",
-	"pantone 7482 c":          "#009d4e",
-	"pantone 7482 u":          "#1da46f",
-	"pantone 7483 c":          "#235c37",
-	"pantone 7483 u":          "#567662",
-	"pantone 7484 c":          "#00563f",
-	"pantone 7484 u":          "#436d60",
-	"pantone 7485 c":          "#d1ddba",
-	"pantone 7485 u":          "#d5e3c0",
-	"pantone 7486 c":          "#bcdf92",
-	"pantone 7486 u":          "#b4e192",
-	"pantone 7487 c":          "#8bdc64",
-	"pantone 7487 u":          "#84df75",
-	"pantone 7488 c":          "#72d54a",
-	"pantone 7488 u":          "#5ace5a",
-	"pantone 7489 c":          "#72a84f",
-	"pantone 7489 u":          "#79a96b",
-	"pantone 7490 c":          "#739849",
-	"pantone 7490 u":          "#7a9d6c",
-	"pantone 7491 c":          "#79843b",
-	"pantone 7491 u":          "#808a60",
-	"pantone 7492 c":          "#c2ca7f",
-	"pantone 7492 u":          "#bcc986",
-	"pantone 7493 c":          "#bdc391",
-	"pantone 7493 u":          "#b5c093",
-	"pantone 7494 c":          "#9cae87",
-	"pantone 7494 u":          "#9dae90",
-	"pantone 7495 c":          "#90993d",
-	"pantone 7495 u":          "#828d58",
-	"pantone 7496 c":          "#77871c",
-	"pantone 7496 u":          "#738348",
-	"pantone 7497 c":          "#7b7156",
-	"pantone 7497 u":          "#8b8776",
-	"pantone 7498 c":          "#5c6134",
-	"pantone 7498 u":          "#767b63",
-	"pantone 7499 c":          "#f2e4b1",
-	"pantone 7499 u":          "#faecbb",
-	"pantone 7500 c":          "#e1d0a5",
-	"pantone 7500 u":          "#e6d4ab",
-	"pantone 7501 c":          "#dac79d",
-	"pantone 7501 u":          "#dfc9a4",
-	"pantone 7502 c":          "#d1b786",
-	"pantone 7502 u":          "#c9ae86",
-	"pantone 7503 c":          "#ab9766",
-	"pantone 7503 u":          "#9e9171",
-	"pantone 7504 c":          "#96785d",
-	"pantone 7504 u":          "#968171",
-	"pantone 7505 c":          "#83603f",
-	"pantone 7505 u":          "#887465",
-	"pantone 7506 c":          "#f3dab1",
-	"pantone 7506 u":          "#fbdfb7",
-	"pantone 7507 c":          "#fed198",
-	"pantone 7507 u":          "#ffdca9",
-	"pantone 7508 c":          "#e2b77d",
-	"pantone 7508 u":          "#e0b384",
-	"pantone 7509 c":          "#d8a460",
-	"pantone 7509 u":          "#d2a172",
-	"pantone 7510 c":          "#c8893c",
-	"pantone 7510 u":          "#be8b5f",
-	"pantone 7511 c":          "#ba7726",
-	"pantone 7511 u":          "#a8774f",
-	"pantone 7512 c":          "#a86318",
-	"pantone 7512 u":          "#986a47",
-	"pantone 7513 c":          "#e3b6a4",
-	"pantone 7513 u":          "#e7bab2",
-	"pantone 7514 c":          "#d7a284",
-	"pantone 7514 u":          "#dbaa9a",
-	"pantone 7515 c":          "#c78b66",
-	"pantone 7515 u":          "#c4907e",
-	"pantone 7516 c":          "#9c542a",
-	"pantone 7516 u":          "#a17462",
-	"pantone 7517 c":          "#89421c",
-	"pantone 7517 u":          "#946856",
-	"pantone 7518 c":          "#6f4e46",
-	"pantone 7518 u":          "#807070",
-	"pantone 7519 c":          "#5f4a3b",
-	"pantone 7519 u":          "#746a65",
-	"pantone 7520 c":          "#edbeaf",
-	"pantone 7520 u":          "#f3c4bf",
-	"pantone 7521 c":          "#c49a81",
-	"pantone 7521 u":          "#c7a095",
-	"pantone 7522 c":          "#ba6954",
-	"pantone 7522 u":          "#b27670",
-	"pantone 7523 c":          "#af5c56",
-	"pantone 7523 u":          "#ab7475",
-	"pantone 7524 c":          "#a85147",
-	"pantone 7524 u":          "#a56d69",
-	"pantone 7525 c":          "#9f684f",
-	"pantone 7525 u":          "#9b776e",
-	"pantone 7526 c":          "#8d3a18",
-	"pantone 7526 u":          "#935e50",
-	"pantone 7527 c":          "#d7d1c4",
-	"pantone 7527 u":          "#dcd6d1",
-	"pantone 7528 c":          "#c7b9ab",
-	"pantone 7528 u":          "#d2c7c3",
-	"pantone 7529 c":          "#b7a998",
-	"pantone 7529 u":          "#baaea8",
-	"pantone 7530 c":          "#a49382",
-	"pantone 7530 u":          "#a79b94",
-	"pantone 7531 c":          "#7c6755",
-	"pantone 7531 u":          "#8a7f78",
-	"pantone 7532 c":          "#65503c",
-	"pantone 7532 u":          "#786d64",
-	"pantone 7533 c":          "#483627",
-	"pantone 7533 u":          "#6d645b",
-	"pantone 7534 c":          "#d3ccbc",
-	"pantone 7534 u":          "#d0c9c2",
-	"pantone 7535 c":          "#b8b09b",
-	"pantone 7535 u":          "#c0b9b0",
-	"pantone 7536 c":          "#a89f87",
-	"pantone 7536 u":          "#afa79c",
-	"pantone 7537 c":          "#a9aba0",
-	"pantone 7537 u":          "#b3b7b5",
-	"pantone 7538 c":          "#93998f",
-	"pantone 7538 u":          "#a3a9a7",
-	"pantone 7539 c":          "#8f8e88",
-	"pantone 7539 u":          "#9b9d9f",
-	"pantone 7540 c":          "#4b4e53",
-	"pantone 7540 u":          "#6f737b",
-	"pantone 7541 c":          "#dadfe1",
-	"pantone 7541 u":          "#dae3e6",
-	"pantone 7542 c":          "#a5bbc2",
-	"pantone 7542 u":          "#b0c3cb",
-	"pantone 7543 c":          "#98a3ad",
-	"pantone 7543 u":          "#adb7c0",
-	"pantone 7544 c":          "#768591",
-	"pantone 7544 u":          "#98a3ad",
-	"pantone 7545 c":          "#425363",
-	"pantone 7545 u":          "#77838e",
-	"pantone 7546 c":          "#263645",
-	"pantone 7546 u":          "#676f79",
-	"pantone 7547 c":          "#141d28",
-	"pantone 7547 u":          "#50525b",
-	"pantone 7548 c":          "#ffc600",
-	"pantone 7548 u":          "#ffc908",
-	"pantone 7549 c":          "#ffb600",
-	"pantone 7549 u":          "#ffb835",
-	"pantone 7550 c":          "#d49000",
-	"pantone 7550 u":          "#ce963b",
-	"pantone 7551 c":          "#b57f00",
-	"pantone 7551 u":          "#ae8542",
-	"pantone 7552 c":          "#75531c",
-	"pantone 7552 u":          "#816e49",
-	"pantone 7553 c":          "#5c4520",
-	"pantone 7553 u":          "#6f644a",
-	"pantone 7554 c":          "#4c3d29",
-	"pantone 7554 u":          "#6c6457",
-	"pantone 7555 c":          "#d39e0a",
-	"pantone 7555 u":          "#d8a958",
-	"pantone 7556 c":          "#b88b1c",
-	"pantone 7556 u":          "#bc985c",
-	"pantone 7557 c":          "#a27c21",
-	"pantone 7557 u":          "#a58c5d",
-	"pantone 7558 c":          "#987023",
-	"pantone 7558 u":          "#9a825d",
-	"pantone 7559 c":          "#916928",
-	"pantone 7559 u":          "#987e5e",
-	"pantone 7560 c":          "#7f622c",
-	"pantone 7560 u":          "#8e7d62",
-	"pantone 7561 c":          "#6d5d34",
-	"pantone 7561 u":          "#807863",
-	"pantone 7562 c":          "#bf9a5e",
-	"pantone 7562 u":          "#be9f77",
-	"pantone 7563 c":          "#d99a29",
-	"pantone 7563 u":          "#dba461",
-	"pantone 7564 c":          "#de8a00",
-	"pantone 7564 u":          "#df9856",
-	"pantone 7565 c":          "#cf7a21",
-	"pantone 7565 u":          "#ca8558",
-	"pantone 7566 c":          "#b16432",
-	"pantone 7566 u":          "#b0795d",
-	"pantone 7567 c":          "#8b532e",
-	"pantone 7567 u":          "#916f5b",
-	"pantone 7568 c":          "#795034",
-	"pantone 7568 u":          "#826b5d",
-	"pantone 7569 c":          "#db8823",
-	"pantone 7569 u":          "#d68d56",
-	"pantone 7570 c":          "#d7832a",
-	"pantone 7570 u":          "#d48c59",
-	"pantone 7571 c":          "#ca7c30",
-	"pantone 7571 u":          "#c5885b",
-	"pantone 7572 c":          "#ba7131",
-	"pantone 7572 u":          "#b37f5b",
-	"pantone 7573 c":          "#aa6529",
-	"pantone 7573 u":          "#aa7a57",
-	"pantone 7574 c":          "#a26936",
-	"pantone 7574 u":          "#9a7659",
-	"pantone 7575 c":          "#855c31",
-	"pantone 7575 u":          "#867058",
-	"pantone 7576 c":          "#e1854e",
-	"pantone 7576 u":          "#dd8d6a",
-	"pantone 7577 c":          "#e57d3c",
-	"pantone 7577 u":          "#e48961",
-	"pantone 7578 c":          "#e06a2d",
-	"pantone 7578 u":          "#df7c59",
-	"pantone 7579 c":          "#e15829",
-	"pantone 7579 u":          "#e27356",
-	"pantone 7580 c":          "#c3502f",
-	"pantone 7580 u":          "#c36c57",
-	"pantone 7581 c":          "#894833",
-	"pantone 7581 u":          "#916a5b",
-	"pantone 7582 c":          "#694634",
-	"pantone 7582 u":          "#7a675d",
-	"pantone 7583 c":          "#c9602c",
-	"pantone 7583 u":          "#cd7b5f",
-	"pantone 7584 c":          "#c05626",
-	"pantone 7584 u":          "#c37558",
-	"pantone 7585 c":          "#b55a37",
-	"pantone 7585 u":          "#b97865",
-	"pantone 7586 c":          "#a3512f",
-	"pantone 7586 u":          "#a56f5c",
-	"pantone 7587 c":          "#964a2c",
-	"pantone 7587 u":          "#9b6c5b",
-	"pantone 7588 c":          "#7f4b35",
-	"pantone 7588 u":          "#896c60",
-	"pantone 7589 c":          "#5f4536",
-	"pantone 7589 u":          "#746962",
-	"pantone 7590 c":          "#d7b49d",
-	"pantone 7590 u":          "#debba8",
-	"pantone 7591 c":          "#c57c59",
-	"pantone 7591 u":          "#c98b77",
-	"pantone 7592 c":          "#b65332",
-	"pantone 7592 u":          "#b87060",
-	"pantone 7593 c":          "#a2402a",
-	"pantone 7593 u":          "#a46659",
-	"pantone 7594 c":          "#7f382b",
-	"pantone 7594 u":          "#8c6259",
-	"pantone 7595 c":          "#6e3c2e",
-	"pantone 7595 u":          "#81655c",
-	"pantone 7596 c":          "#5e3c31",
-	"pantone 7596 u":          "#77655f",
-	"pantone 7597 c":          "#d73f22",
-	"pantone 7597 u":          "#db6655",
-	"pantone 7598 c":          "#c24628",
-	"pantone 7598 u":          "#c66b5a",
-	"pantone 7599 c":          "#b73c24",
-	"pantone 7599 u":          "#c0685a",
-	"pantone 7600 c":          "#903e2a",
-	"pantone 7600 u":          "#9d685d",
-	"pantone 7601 c":          "#85412a",
-	"pantone 7601 u":          "#93685b",
-	"pantone 7602 c":          "#7d4930",
-	"pantone 7602 u":          "#8d6e60",
-	"pantone 7603 c":          "#69412f",
-	"pantone 7603 u":          "#7c665c",
-	"pantone 7604 c":          "#e7d4d2",
-	"pantone 7604 u":          "#ebd6d4",
-	"pantone 7605 c":          "#e5b9b3",
-	"pantone 7605 u":          "#ecc3bd",
-	"pantone 7606 c":          "#dc9089",
-	"pantone 7606 u":          "#e39e99",
-	"pantone 7607 c":          "#c86b60",
-	"pantone 7607 u":          "#cf857f",
-	"pantone 7608 c":          "#a9473c",
-	"pantone 7608 u":          "#af6c66",
-	"pantone 7609 c":          "#873934",
-	"pantone 7609 u":          "#95645f",
-	"pantone 7610 c":          "#6b3230",
-	"pantone 7610 u":          "#81605c",
-	"pantone 7611 c":          "#e1bbaf",
-	"pantone 7611 u":          "#e8c7bd",
-	"pantone 7612 c":          "#cd998d",
-	"pantone 7612 u":          "#d5a99f",
-	"pantone 7613 c":          "#bf887e",
-	"pantone 7613 u":          "#c89e96",
-	"pantone 7614 c":          "#a67e73",
-	"pantone 7614 u":          "#af928b",
-	"pantone 7615 c":          "#896760",
-	"pantone 7615 u":          "#978380",
-	"pantone 7616 c":          "#6d4b4b",
-	"pantone 7616 u":          "#867372",
-	"pantone 7617 c":          "#5a3c3c",
-	"pantone 7617 u":          "#746464",
-	"pantone 7618 c":          "#cc6c4e",
-	"pantone 7618 u":          "#d48876",
-	"pantone 7619 c":          "#c74a37",
-	"pantone 7619 u":          "#cb6f63",
-	"pantone 7620 c":          "#bd2e2a",
-	"pantone 7620 u":          "#c55e59",
-	"pantone 7621 c":          "#b21e27",
-	"pantone 7621 u":          "#bf5b5a",
-	"pantone 7622 c":          "#97252b",
-	"pantone 7622 u":          "#a85c5c",
-	"pantone 7623 c":          "#8f2829",
-	"pantone 7623 u":          "#9f5a57",
-	"pantone 7624 c":          "#852c2b",
-	"pantone 7624 u":          "#8f5854",
-	"pantone 7625 c":          "#e94e3c",
-	"pantone 7625 u":          "#f0756c",
-	"pantone 7626 c":          "#cb3524",
-	"pantone 7626 u":          "#d5625c",
-	"pantone 7627 c":          "#ab2b28",
-	"pantone 7627 u":          "#b05856",
-	"pantone 7628 c":          "#a22929",
-	"pantone 7628 u":          "#a95957",
-	"pantone 7629 c":          "#703231",
-	"pantone 7629 u":          "#805b59",
-	"pantone 7630 c":          "#65312f",
-	"pantone 7630 u":          "#765856",
-	"pantone 7631 c":          "#592d2b",
-	"pantone 7631 u":          "#6e5552",
-	"pantone 7632 c":          "#d8c9ca",
-	"pantone 7632 u":          "#ddcecf",
-	"pantone 7633 c":          "#c8a3a6",
-	"pantone 7633 u":          "#cba9ad",
-	"pantone 7634 c":          "#c66583",
-	"pantone 7634 u":          "#cb7b90",
-	"pantone 7635 c":          "#cd3161",
-	"pantone 7635 u":          "#d1607b",
-	"pantone 7636 c":          "#c41a49",
-	"pantone 7636 u":          "#c8546d",
-	"pantone 7637 c":          "#962c45",
-	"pantone 7637 u":          "#975764",
-	"pantone 7638 c":          "#832b40",
-	"pantone 7638 u":          "#87555f",
-	"pantone 7639 c":          "#956c72",
-	"pantone 7639 u":          "#a08489",
-	"pantone 7640 c":          "#973e53",
-	"pantone 7640 u":          "#9d6572",
-	"pantone 7641 c":          "#932a47",
-	"pantone 7641 u":          "#9c5c6c",
-	"pantone 7642 c":          "#752d49",
-	"pantone 7642 u":          "#805666",
-	"pantone 7643 c":          "#692c43",
-	"pantone 7643 u":          "#7a5966",
-	"pantone 7644 c":          "#5a2c3e",
-	"pantone 7644 u":          "#725762",
-	"pantone 7645 c":          "#522a38",
-	"pantone 7645 u":          "#6c565f",
-	"pantone 7646 c":          "#a96c86",
-	"pantone 7646 u":          "#b38599",
-	"pantone 7647 c":          "#ad3b72",
-	"pantone 7647 u":          "#b46889",
-	"pantone 7648 c":          "#9e1b64",
-	"pantone 7648 u":          "#a1537b",
-	"pantone 7649 c":          "#8e195f",
-	"pantone 7649 u":          "#965278",
-	"pantone 7650 c":          "#762056",
-	"pantone 7650 u":          "#855472",
-	"pantone 7651 c":          "#6d295a",
-	"pantone 7651 u":          "#805b77",
-	"pantone 7652 c":          "#612550",
-	"pantone 7652 u":          "#74546b",
-	"pantone 7653 c":          "#978693",
-	"pantone 7653 u":          "#9d939e",
-	"pantone 7654 c":          "#a6769a",
-	"pantone 7654 u":          "#ac88a5",
-	"pantone 7655 c":          "#a55a95",
-	"pantone 7655 u":          "#ab779e",
-	"pantone 7656 c":          "#93397f",
-	"pantone 7656 u":          "#97638c",
-	"pantone 7657 c":          "#702b62",
-	"pantone 7657 u":          "#7f5876",
-	"pantone 7658 c":          "#6d335e",
-	"pantone 7658 u":          "#7c5d75",
-	"pantone 7659 c":          "#5f3552",
-	"pantone 7659 u":          "#6f596b",
-	"pantone 7660 c":          "#9b8fa2",
-	"pantone 7660 u":          "#9d95a8",
-	"pantone 7661 c":          "#906c95",
-	"pantone 7661 u":          "#957d9b",
-	"pantone 7662 c":          "#7d4081",
-	"pantone 7662 u":          "#86658b",
-	"pantone 7663 c":          "#6d3075",
-	"pantone 7663 u":          "#7b5c82",
-	"pantone 7664 c":          "#683177",
-	"pantone 7664 u":          "#765c83",
-	"pantone 7665 c":          "#62366e",
-	"pantone 7665 u":          "#735d7b",
-	"pantone 7666 c":          "#5e4d62",
-	"pantone 7666 u":          "#716876",
-	"pantone 7667 c":          "#6f7ba0",
-	"pantone 7667 u":          "#828ba8",
-	"pantone 7668 c":          "#696d9f",
-	"pantone 7668 u":          "#7b7fa3",
-	"pantone 7669 c":          "#635d9b",
-	"pantone 7669 u":          "#7777a1",
-	"pantone 7670 c":          "#585094",
-	"pantone 7670 u":          "#6f6d99",
-	"pantone 7671 c":          "#544487",
-	"pantone 7671 u":          "#6d6892",
-	"pantone 7672 c":          "#4e4084",
-	"pantone 7672 u":          "#6b658d",
-	"pantone 7673 c":          "#545386",
-	"pantone 7673 u":          "#6c6c8c",
-	"pantone 7674 c":          "#8a8ab3",
-	"pantone 7674 u":          "#9194b7",
-	"pantone 7675 c":          "#7e7eaa",
-	"pantone 7675 u":          "#868aae",
-	"pantone 7676 c":          "#77649f",
-	"pantone 7676 u":          "#8279a3",
-	"pantone 7677 c":          "#725090",
-	"pantone 7677 u":          "#7d6c96",
-	"pantone 7678 c":          "#6a478d",
-	"pantone 7678 u":          "#756591",
-	"pantone 7679 c":          "#583c80",
-	"pantone 7679 u":          "#6f618a",
-	"pantone 7680 c":          "#543177",
-	"pantone 7680 u":          "#69587f",
-	"pantone 7681 c":          "#95a7cb",
-	"pantone 7681 u":          "#9aaed0",
-	"pantone 7682 c":          "#6986b7",
-	"pantone 7682 u":          "#7f96c0",
-	"pantone 7683 c":          "#446ba8",
-	"pantone 7683 u":          "#6681ae",
-	"pantone 7684 c":          "#365d9d",
-	"pantone 7684 u":          "#6075a3",
-	"pantone 7685 c":          "#2e5596",
-	"pantone 7685 u":          "#5c709c",
-	"pantone 7686 c":          "#1d4e8f",
-	"pantone 7686 u":          "#536895",
-	"pantone 7687 c":          "#1d4189",
-	"pantone 7687 u":          "#4f5e8c",
-	"pantone 7688 c":          "#4497cb",
-	"pantone 7688 u":          "#5a98ca",
-	"pantone 7689 c":          "#288dc0",
-	"pantone 7689 u":          "#5292c2",
-	"pantone 7690 c":          "#0075a8",
-	"pantone 7690 u":          "#477ea7",
-	"pantone 7691 c":          "#006097",
-	"pantone 7691 u":          "#447097",
-	"pantone 7692 c":          "#005486",
-	"pantone 7692 u":          "#4a6a8c",
-	"pantone 7693 c":          "#004876",
-	"pantone 7693 u":          "#4a6381",
-	"pantone 7694 c":          "#00416a",
-	"pantone 7694 u":          "#4c5f78",
-	"pantone 7695 c":          "#7ca5ba",
-	"pantone 7695 u":          "#80a7bc",
-	"pantone 7696 c":          "#6199ae",
-	"pantone 7696 u":          "#6e9aae",
-	"pantone 7697 c":          "#4e869f",
-	"pantone 7697 u":          "#688ea3",
-	"pantone 7698 c":          "#41738c",
-	"pantone 7698 u":          "#637e91",
-	"pantone 7699 c":          "#35647d",
-	"pantone 7699 u":          "#5c7588",
-	"pantone 7700 c":          "#1c5a7c",
-	"pantone 7700 u":          "#547088",
-	"pantone 7701 c":          "#005677",
-	"pantone 7701 u":          "#45697f",
-	"pantone 7702 c":          "#45a7c5",
-	"pantone 7702 u":          "#5aa8c7",
-	"pantone 7703 c":          "#009abe",
-	"pantone 7703 u":          "#419ebf",
-	"pantone 7704 c":          "#0083ac",
-	"pantone 7704 u":          "#2f89ac",
-	"pantone 7705 c":          "#006e96",
-	"pantone 7705 u":          "#387b9a",
-	"pantone 7706 c":          "#00698e",
-	"pantone 7706 u":          "#407895",
-	"pantone 7707 c":          "#005f7f",
-	"pantone 7707 u":          "#437288",
-	"pantone 7708 c":          "#00546f",
-	"pantone 7708 u":          "#41697d",
-	"pantone 7709 c":          "#63b0bb",
-	"pantone 7709 u":          "#6ab0bc",
-	"pantone 7710 c":          "#00a5b5",
-	"pantone 7710 u":          "#39a8b8",
-	"pantone 7711 c":          "#0095a8",
-	"pantone 7711 u":          "#009bac",
-	"pantone 7712 c":          "#00829a",
-	"pantone 7712 u":          "#1e899d",
-	"pantone 7713 c":          "#007a89",
-	"pantone 7713 u":          "#258492",
-	"pantone 7714 c":          "#00747f",
-	"pantone 7714 u":          "#2e7f8a",
-	"pantone 7715 c":          "#006067",
-	"pantone 7715 u":          "#3f6f77",
-	"pantone 7716 c":          "#00948f",
-	"pantone 7716 u":          "#439796",
-	"pantone 7717 c":          "#00827d",
-	"pantone 7717 u":          "#378c8b",
-	"pantone 7718 c":          "#007473",
-	"pantone 7718 u":          "#308082",
-	"pantone 7719 c":          "#006b67",
-	"pantone 7719 u":          "#3c7979",
-	"pantone 7720 c":          "#00615b",
-	"pantone 7720 u":          "#437575",
-	"pantone 7721 c":          "#005c5c",
-	"pantone 7721 u":          "#477174",
-	"pantone 7722 c":          "#004f50",
-	"pantone 7722 u":          "#48696e",
-	"pantone 7723 c":          "#4ea585",
-	"pantone 7723 u":          "#67aa91",
-	"pantone 7724 c":          "#00946d",
-	"pantone 7724 u":          "#4c9980",
-	"pantone 7725 c":          "#008555",
-	"pantone 7725 u":          "#358f71",
-	"pantone 7726 c":          "#007a4b",
-	"pantone 7726 u":          "#328369",
-	"pantone 7727 c":          "#006d45",
-	"pantone 7727 u":          "#3b7a64",
-	"pantone 7728 c":          "#006645",
-	"pantone 7728 u":          "#427665",
-	"pantone 7729 c":          "#005744",
-	"pantone 7729 u":          "#456e67",
-	"pantone 7730 c":          "#4b9461",
-	"pantone 7730 u":          "#659979",
-	"pantone 7731 c":          "#208648",
-	"pantone 7731 u":          "#55906b",
-	"pantone 7732 c":          "#00783e",
-	"pantone 7732 u":          "#468364",
-	"pantone 7733 c":          "#006e42",
-	"pantone 7733 u":          "#497a62",
-	"pantone 7734 c":          "#286040",
-	"pantone 7734 u":          "#567564",
-	"pantone 7735 c":          "#37563c",
-	"pantone 7735 u":          "#5f6f62",
-	"pantone 7736 c":          "#385441",
-	"pantone 7736 u":          "#5a6a62",
-	"pantone 7737 c":          "#6ca438",
-	"pantone 7737 u":          "#79aa66",
-	"pantone 7738 c":          "#46a040",
-	"pantone 7738 u":          "#63a768",
-	"pantone 7739 c":          "#2e9941",
-	"pantone 7739 u":          "#58a26a",
-	"pantone 7740 c":          "#368f3f",
-	"pantone 7740 u":          "#5a9665",
-	"pantone 7741 c":          "#41873e",
-	"pantone 7741 u":          "#649166",
-	"pantone 7742 c":          "#48763a",
-	"pantone 7742 u":          "#648464",
-	"pantone 7743 c":          "#41683b",
-	"pantone 7743 u":          "#5c735d",
-	"pantone 7744 c":          "#babb13",
-	"pantone 7744 u":          "#b8bc55",
-	"pantone 7745 c":          "#abac21",
-	"pantone 7745 u":          "#aeb25c",
-	"pantone 7746 c":          "#9b9a30",
-	"pantone 7746 u":          "#9b9e5f",
-	"pantone 7747 c":          "#8a8c30",
-	"pantone 7747 u":          "#8f9561",
-	"pantone 7748 c":          "#7e7f34",
-	"pantone 7748 u":          "#878b62",
-	"pantone 7749 c":          "#737335",
-	"pantone 7749 u":          "#7f8261",
-	"pantone 7750 c":          "#666534",
-	"pantone 7750 u":          "#767960",
-	"pantone 7751 c":          "#cbb44a",
-	"pantone 7751 u":          "#d1bc71",
-	"pantone 7752 c":          "#d1ae1e",
-	"pantone 7752 u":          "#d6b761",
-	"pantone 7753 c":          "#c3a01c",
-	"pantone 7753 u":          "#c6aa5c",
-	"pantone 7754 c":          "#a28527",
-	"pantone 7754 u":          "#a4905d",
-	"pantone 7755 c":          "#8b752f",
-	"pantone 7755 u":          "#90845f",
-	"pantone 7756 c":          "#746635",
-	"pantone 7756 u":          "#837c61",
-	"pantone 7757 c":          "#695d31",
-	"pantone 7757 u":          "#79745f",
-	"pantone 7758 c":          "#d6c200",
-	"pantone 7758 u":          "#dac856",
-	"pantone 7759 c":          "#c6b200",
-	"pantone 7759 u":          "#c8b751",
-	"pantone 7760 c":          "#93832b",
-	"pantone 7760 u":          "#958e5e",
-	"pantone 7761 c":          "#757034",
-	"pantone 7761 u":          "#828060",
-	"pantone 7762 c":          "#5e6338",
-	"pantone 7762 u":          "#727862",
-	"pantone 7763 c":          "#5a5b3a",
-	"pantone 7763 u":          "#707362",
-	"pantone 7764 c":          "#545333",
-	"pantone 7764 u":          "#6c6d5f",
-	"pantone 7765 c":          "#bbb21e",
-	"pantone 7765 u":          "#c0b95e",
-	"pantone 7766 c":          "#b5a81b",
-	"pantone 7766 u":          "#bcb25e",
-	"pantone 7767 c":          "#ab9c2c",
-	"pantone 7767 u":          "#b1a561",
-	"pantone 7768 c":          "#8e7e33",
-	"pantone 7768 u":          "#948b61",
-	"pantone 7769 c":          "#726032",
-	"pantone 7769 u":          "#80775d",
-	"pantone 7770 c":          "#645937",
-	"pantone 7770 u":          "#76715f",
-	"pantone 7771 c":          "#4f4932",
-	"pantone 7771 u":          "#666359",
-	"pantone black 2 c":       "#332e1f",
-	"pantone black 2 u":       "#625f51",
-	"pantone black 3 c":       "#212620",
-	"pantone black 3 u":       "#575c58",
-	"pantone black 4 c":       "#31251c",
-	"pantone black 4 u":       "#685f54",
-	"pantone black 5 c":       "#3f2a2e",
-	"pantone black 5 u":       "#68595b",
-	"pantone black 6 c":       "#10181f",
-	"pantone black 6 u":       "#4a4d56",
-	"pantone black 7 c":       "#3d3834",
-	"pantone black 7 u":       "#6d6763",
-	"pantone black c":         "#2e2925",
-	"pantone black u":         "#615c59",
-	"pantone blue 072 c":      "#050d9e",
-	"pantone blue 072 u":      "#3943ac",
-	"pantone cool gray 1 c":   "#dad8d6",
-	"pantone cool gray 1 u":   "#dad8d6",
-	"pantone cool gray 10 c":  "#646469",
-	"pantone cool gray 10 u":  "#7f8286",
-	"pantone cool gray 11 c":  "#555559",
-	"pantone cool gray 11 u":  "#797c80",
-	"pantone cool gray 2 c":   "#d2cfcd",
-	"pantone cool gray 2 u":   "#cac9c8",
-	"pantone cool gray 3 c":   "#c9c8c7",
-	"pantone cool gray 3 u":   "#c5c3c3",
-	"pantone cool gray 4 c":   "#bcbbba",
-	"pantone cool gray 4 u":   "#b7b6b8",
-	"pantone cool gray 5 c":   "#b3b1b1",
-	"pantone cool gray 5 u":   "#abaeb0",
-	"pantone cool gray 6 c":   "#a9a8a9",
-	"pantone cool gray 6 u":   "#a2a4a6",
-	"pantone cool gray 7 c":   "#999899",
-	"pantone cool gray 7 u":   "#9b9d9f",
-	"pantone cool gray 8 c":   "#8a8a8d",
-	"pantone cool gray 8 u":   "#919498",
-	"pantone cool gray 9 c":   "#777779",
-	"pantone cool gray 9 u":   "#878a8e",
-	"pantone green c":         "#00a887",
-	"pantone green u":         "#00a88e",
-	"pantone orange 021 c":    "#ff5000",
-	"pantone orange 021 u":    "#ff6d2e",
-	"pantone process blue c":  "#0081c9",
-	"pantone process blue u":  "#0081c4",
-	"pantone purple c":        "#c126b8",
-	"pantone purple u":        "#c252b4",
-	"pantone red 032 c":       "#f6323e",
-	"pantone red 032 u":       "#fd4f57",
-	"pantone reflex blue c":   "#001588",
-	"pantone reflex blue u":   "#38499b",
-	"pantone rhodamine red c": "#e70094",
-	"pantone rhodamine red u": "#e94b97",
-	"pantone rubine red c":    "#d60056",
-	"pantone rubine red u":    "#e1457c",
-	"pantone violet c":        "#430098",
-	"pantone violet u":        "#7459b1",
-	"pantone warm gray 1 c":   "#d8d1c9",
-	"pantone warm gray 1 u":   "#dbd4cd",
-	"pantone warm gray 10 c":  "#7b6d65",
-	"pantone warm gray 10 u":  "#827a78",
-	"pantone warm gray 11 c":  "#706258",
-	"pantone warm gray 11 u":  "#7d7573",
-	"pantone warm gray 2 c":   "#cdc3bb",
-	"pantone warm gray 2 u":   "#cec7c0",
-	"pantone warm gray 3 c":   "#c1b7af",
-	"pantone warm gray 3 u":   "#beb5af",
-	"pantone warm gray 4 c":   "#b6ada4",
-	"pantone warm gray 4 u":   "#b3aaa4",
-	"pantone warm gray 5 c":   "#aea198",
-	"pantone warm gray 5 u":   "#a8a09b",
-	"pantone warm gray 6 c":   "#a79b94",
-	"pantone warm gray 6 u":   "#a29a95",
-	"pantone warm gray 7 c":   "#978b82",
-	"pantone warm gray 7 u":   "#978e8a",
-	"pantone warm gray 8 c":   "#8e8279",
-	"pantone warm gray 8 u":   "#8e8682",
-	"pantone warm gray 9 c":   "#85776f",
-	"pantone warm gray 9 u":   "#867e7c",
-	"pantone warm red c":      "#ff4337",
-	"pantone warm red u":      "#ff665b",
-	"pantone yellow c":        "#ffdd00",
-	"pantone yellow u":        "#ffe700",
-	"pantone 877 c":           "#8a8d8f",
-}
@@ -0,0 +1,61 @@
+package dzi
+
+import (
+	"math"
+
+	"github.com/brandquad/dzi/assets"
+	dzi "github.com/brandquad/dzi/colorutils"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+type SwatchMatch struct {
+	Name    string  `json:"name"`
+	DeltaE  float64 `json:"delta_e"`
+	Library string  `json:"library"`
+}
+
+// hex2lab convert swatch preview color to Lab, nil when color is unknown
+func hex2lab(hex string) []float64 {
+	if hex == "" {
+		return nil
+	}
+	color, err := colorful.Hex(hex)
+	if err != nil {
+		return nil
+	}
+	red, green, blue := color.RGB255()
+	return dzi.Rgb2lab([]int{int(red), int(green), int(blue)})
+}
+
+// roundComponents return copy of color components rounded to hundredths
+func roundComponents(components []float64) []float64 {
+	if components == nil {
+		return nil
+	}
+	rounded := make([]float64, len(components))
+	for i, v := range components {
+		rounded[i] = math.Round(v*100) / 100
+	}
+	return rounded
+}
+
+// librarySource return swatch source for color found in the book
+func librarySource(book *assets.Book) SwatchSource {
+	if book.Custom {
+		return SourceCustomer
+	}
+	return SourceLibrary
+}
+
+// nearest find the closest spot library color to swatch Lab color
+func (r *colorResolver) nearest(lab []float64) *SwatchMatch {
+	matches := r.books.Nearest(lab, 1)
+	if len(matches) == 0 {
+		return nil
+	}
+	return &SwatchMatch{
+		Name:    matches[0].Color.Name,
+		DeltaE:  math.Round(matches[0].DeltaE*100) / 100,
+		Library: matches[0].Library,
+	}
+}
@@ -0,0 +1,138 @@
+package dzi
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/brandquad/dzi/assets"
+	dzi "github.com/brandquad/dzi/colorutils"
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// minNameConfidence name matches with lower confidence are ignored
+const minNameConfidence = 0.5
+
+// vipsDefaultCMYKProfile built-in libvips CMYK profile, used by icc_transform for CMYK images without a profile
+const vipsDefaultCMYKProfile = "cmyk"
+
+// colorResolver convert swatch colors of a job through the same ICC profiles as the composite
+type colorResolver struct {
+	outputProfile string
+	inputProfile  string
+	outputIntent  string
+	books         *assets.Registry
+
+	mu    sync.Mutex
+	cache map[[4]float64]string
+}
+
+func newColorResolver(c *Config) (*colorResolver, error) {
+	normalizer, err := assets.LoadNormalizer(c.NameAliasesPath)
+	if err != nil {
+		return nil, err
+	}
+	books, err := assets.NewRegistry(c.ColorBooksPath, normalizer)
+	if err != nil {
+		return nil, err
+	}
+	return &colorResolver{
+		outputProfile: c.ICCProfileFilepath,
+		inputProfile:  vipsDefaultCMYKProfile,
+		books:         books,
+		cache:         make(map[[4]float64]string),
+	}, nil
+}
+
+// confident return name match when it is good enough to use, otherwise nil
+func confident(m *assets.NameMatch) *assets.NameMatch {
+	if m == nil || m.Confidence < minNameConfidence {
+		return nil
+	}
+	return m
+}
+
+// useOutputIntent extract PDF output intent profile to outputPath and use it as CMYK input profile.
+// Resolver keeps the default profile when PDF has no output intent.
+func (r *colorResolver) useOutputIntent(fileName, outputPath string) error {
+	// Profile goes to the file, so mutool warnings do not mix with it
+	if _, err := execCmd("mutool", "show", "-b", "-o", outputPath, fileName, "trailer/Root/OutputIntents/0/DestOutputProfile"); err != nil {
+		log.Printf("[-] No output intent profile: %v", err)
+		_ = os.Remove(outputPath)
+		return nil
+	}
+	buff, err := os.ReadFile(outputPath)
+	if err != nil {
+		return err
+	}
+	if len(buff) < 128 || string(buff[36:40]) != "acsp" {
+		log.Println("[-] No output intent profile")
+		return os.Remove(outputPath)
+	}
+	log.Println("[*] Use output intent profile", outputPath)
+	r.inputProfile = outputPath
+	r.outputIntent = outputPath
+	return nil
+}
+
+// cmykToHex convert CMYK components in percents to RGB hex string.
+// Falls back to the naive formula when ICC transform fails.
+func (r *colorResolver) cmykToHex(cmyk []float64) string {
+	key := [4]float64{cmyk[0], cmyk[1], cmyk[2], cmyk[3]}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.cache[key]; ok {
+		return v
+	}
+
+	rgb, err := r.transformCMYK(cmyk)
+	if err != nil {
+		log.Printf("[!] Error ICC transform of %v: %v", cmyk, err)
+		rgb = dzi.Cmyk2rgb(cmyk)
+	}
+	hex := rgb2hex(rgb)
+	r.cache[key] = hex
+	return hex
+}
+
+func (r *colorResolver) transformCMYK(cmyk []float64) ([]int, error) {
+	ref, err := vips.Black(1, 1)
+	if err != nil {
+		return nil, err
+	}
+	defer ref.Close()
+
+	if err = ref.BandJoinConst([]float64{0, 0, 0}); err != nil {
+		return nil, err
+	}
+	values := make([]float64, 4)
+	for i := range values {
+		values[i] = cmyk[i] * 255 / 100
+	}
+	if err = ref.Linear([]float64{1, 1, 1, 1}, values); err != nil {
+		return nil, err
+	}
+	if err = ref.Cast(vips.BandFormatUchar); err != nil {
+		return nil, err
+	}
+	cmykRef, err := ref.CopyChangingInterpretation(vips.InterpretationCMYK)
+	if err != nil {
+		return nil, err
+	}
+	defer cmykRef.Close()
+
+	if err = cmykRef.TransformICCProfileWithFallback(r.outputProfile, r.inputProfile); err != nil {
+		return nil, err
+	}
+	point, err := cmykRef.GetPoint(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(point) < 3 {
+		return nil, fmt.Errorf("unexpected bands count %d", len(point))
+	}
+	return []int{int(point[0]), int(point[1]), int(point[2])}, nil
+}
@@ -14,7 +14,9 @@ import (
 	"path"
 )
 
-func processSwatch(page *pageInfo, swatch *Swatch, colorizedFolder, bwFolder string) error {
+// processSwatch colorize separation and keep its black-white copy.
+// Alpha plate is written when alphaFolder is set.
+func processSwatch(page *pageInfo, swatch *Swatch, colorizedFolder, bwFolder, alphaFolder string) error {
 	st := time.Now()
 
 	log.Printf("[>] Colorize %s page %d", swatch.Name, page.PageNumber)
@@ -47,6 +49,14 @@ func processSwatch(page *pageInfo, swatch *Swatch, colorizedFolder, bwFolder str
 			return err
 		}
 
+		if alphaFolder != "" && (swatch.Type == CmykComponent || swatch.Type == SpotComponent) {
+			alphaFilepath := path.Join(alphaFolder, fmt.Sprintf("%s.png", swatch.Filename()))
+			if err = alphaPlate(ref, rgbMateColor, alphaFilepath); err != nil {
+				return err
+			}
+			swatch.AlphaFilepath = alphaFilepath
+		}
+
 		if err = ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
 			return err
 		}
@@ -93,6 +103,40 @@ func processSwatch(page *pageInfo, swatch *Swatch, colorizedFolder, bwFolder str
 	return nil
 }
 
+// alphaPlate write separation as flat ink color with alpha channel from ink density
+func alphaPlate(ref *vips.ImageRef, color colorful.Color, output string) error {
+	alpha, err := ref.Copy()
+	if err != nil {
+		return err
+	}
+	defer alpha.Close()
+	if alpha.Bands() > 1 {
+		if err = alpha.ExtractBand(0, 1); err != nil {
+			return err
+		}
+	}
+	// Separation is black where ink is full
+	if err = alpha.Linear([]float64{-1}, []float64{255}); err != nil {
+		return err
+	}
+	if err = alpha.Cast(vips.BandFormatUchar); err != nil {
+		return err
+	}
+
+	plate, err := createImage(ref.Width(), ref.Height(), color)
+	if err != nil {
+		return err
+	}
+	defer plate.Close()
+	if err = plate.Cast(vips.BandFormatUchar); err != nil {
+		return err
+	}
+	if err = plate.BandJoin(alpha); err != nil {
+		return err
+	}
+	return toPng(plate, output)
+}
+
 func prepareFolders(page *pageInfo, folderPrefix ...string) ([]string, error) {
 	folders := make([]string, len(folderPrefix))
 	for idx, prefix := range folderPrefix {
@@ -105,7 +149,7 @@ func prepareFolders(page *pageInfo, folderPrefix ...string) ([]string, error) {
 	return folders, nil
 }
 
-func colorize(pages []*pageInfo, _outputColorized, _outputBw, _leads1000, _covers string, c *Config) error {
+func colorize(pages []*pageInfo, _outputColorized, _outputBw, _outputAlpha, _leads1000, _covers string, c *Config) error {
 	st := time.Now()
 	var ref, mateRef *vips.ImageRef
 	//var err error
@@ -129,15 +173,19 @@ func colorize(pages []*pageInfo, _outputColorized, _outputBw, _leads1000, _cover
 	for _, page := range pages {
 
 		// Output paths
-		folders, err := prepareFolders(page, _outputColorized, _outputBw, _leads1000, _covers)
+		folders, err := prepareFolders(page, _outputColorized, _outputBw, _leads1000, _covers, _outputAlpha)
 		if err != nil {
 			return err
 		}
 		colorizedFolder, bwFolder := folders[0], folders[1]
+		var alphaFolder string
+		if c.AlphaChannels && page.ColorMode == ColorModeCMYK {
+			alphaFolder = folders[4]
+		}
 
 		for _, swatch := range page.Swatches {
 			pool.Submit(func() {
-				if err := processSwatch(page, swatch, colorizedFolder, bwFolder); err != nil {
+				if err := processSwatch(page, swatch, colorizedFolder, bwFolder, alphaFolder); err != nil {
 					log.Println(err, swatch)
 					panic(err)
 				}
@@ -151,5 +199,16 @@ func colorize(pages []*pageInfo, _outputColorized, _outputBw, _leads1000, _cover
 		return errors.New("error on colorize")
 	}
 
+	for _, page := range pages {
+		if len(c.ExcludeInkRoles) > 0 {
+			if err := excludeInks(page, c.ExcludeInkRoles, path.Join(_outputBw, page.Prefix), c); err != nil {
+				return err
+			}
+		}
+		if err := applySubstrate(page, path.Join(_outputBw, page.Prefix), c); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
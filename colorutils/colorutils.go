@@ -1,6 +1,9 @@
 package dzi
 
-import "math"
+import (
+	"math"
+	"sort"
+)
 
 // Cmyk2rgb converts a CMYK color value to RGB
 func Cmyk2rgb(cmyk []float64) []int {
@@ -11,53 +14,183 @@ func Cmyk2rgb(cmyk []float64) []int {
 	return []int{int(math.Ceil(r)), int(math.Ceil(g)), int(math.Ceil(b))}
 }
 
-// Lab2rgb converts a LAB color value to RGB
+// d50White is the D50 reference white of ICC PCS, Lab values of color books, PDF and ASE files use it
+var d50White = [3]float64{0.96422, 1.00000, 0.82521}
+
+// Lab2rgb converts a LAB (D50) color value to sRGB, white point is adapted with Bradford transform
 func Lab2rgb(lab []float64) []int {
-	var y float64 = (lab[0] + 16) / 116
-	var x float64 = lab[1]/500 + y
-	var z float64 = y - lab[2]/200
-	var r, g, b float64
+	fy := (lab[0] + 16) / 116
+	fx := lab[1]/500 + fy
+	fz := fy - lab[2]/200
 
-	if x*x*x > 0.008856 {
-		x = 0.95047 * x * x * x
-	} else {
-		x = 0.95047 * ((x - 16/116) / 7.787)
+	finv := func(t float64) float64 {
+		if t*t*t > 216.0/24389 {
+			return t * t * t
+		}
+		return (116*t - 16) * 27 / 24389
 	}
-	if y*y*y > 0.008856 {
-		y = 1.00000 * (y * y * y)
-	} else {
-		y = 1.00000 * ((y - 16/116) / 7.787)
+	rgb := Xyz2linearRgb([]float64{d50White[0] * finv(fx), d50White[1] * finv(fy), d50White[2] * finv(fz)})
+
+	gamma := func(c float64) int {
+		if c > 0.0031308 {
+			c = 1.055*math.Pow(c, 1/2.4) - 0.055
+		} else {
+			c = 12.92 * c
+		}
+		return int(math.Round(math.Max(0, math.Min(1, c)) * 255))
 	}
-	if z*z*z > 0.008856 {
-		z = 1.08883 * (z * z * z)
-	} else {
-		z = 1.08883 * ((z - 16/116) / 7.787)
+	return []int{gamma(rgb[0]), gamma(rgb[1]), gamma(rgb[2])}
+}
+
+// Xyz2linearRgb converts a XYZ color value relative to D50 to linear sRGB, white point is adapted
+// with Bradford transform, so D50 white is 1, 1, 1. Values are not clipped.
+func Xyz2linearRgb(xyz []float64) []float64 {
+	x, y, z := xyz[0], xyz[1], xyz[2]
+	return []float64{
+		x*3.1338561 + y*-1.6168667 + z*-0.4906146,
+		x*-0.9787684 + y*1.9161415 + z*0.0334540,
+		x*0.0719453 + y*-0.2289914 + z*1.4052427,
 	}
+}
 
-	r = x*3.2406 + y*-1.5372 + z*-0.4986
-	g = x*-0.9689 + y*1.8758 + z*0.0415
-	b = x*0.0557 + y*-0.2040 + z*1.0570
+// Rgb2lab converts a sRGB color value to LAB (D50), white point is adapted with Bradford transform
+// as in ICC profiles, so the values are comparable with color books
+func Rgb2lab(rgb []int) []float64 {
+	linear := func(v int) float64 {
+		c := float64(v) / 255
+		if c > 0.04045 {
+			return math.Pow((c+0.055)/1.055, 2.4)
+		}
+		return c / 12.92
+	}
+	r, g, b := linear(rgb[0]), linear(rgb[1]), linear(rgb[2])
 
-	if r > 0.0031308 {
-		r = 1.055*math.Pow(r, 1/2.4) - 0.055
-	} else {
-		r = 12.92 * r
+	return Xyz2lab([]float64{
+		r*0.4360747 + g*0.3850649 + b*0.1430804,
+		r*0.2225045 + g*0.7168786 + b*0.0606169,
+		r*0.0139322 + g*0.0971045 + b*0.7141733,
+	})
+}
+
+// Xyz2lab converts a XYZ color value relative to D50 (Y of white is 1) to LAB (D50)
+func Xyz2lab(xyz []float64) []float64 {
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
 	}
+	x, y, z := f(xyz[0]/d50White[0]), f(xyz[1]/d50White[1]), f(xyz[2]/d50White[2])
+
+	return []float64{116*y - 16, 500 * (x - y), 200 * (y - z)}
+}
+
+// DeltaE2000 returns CIEDE2000 color difference between two LAB color values
+func DeltaE2000(lab1, lab2 []float64) float64 {
+	l1, a1, b1 := lab1[0], lab1[1], lab1[2]
+	l2, a2, b2 := lab2[0], lab2[1], lab2[2]
 
-	if g > 0.0031308 {
-		g = 1.055*math.Pow(g, 1/2.4) - 0.055
-	} else {
-		g = 12.92 * g
+	c1 := math.Hypot(a1, b1)
+	c2 := math.Hypot(a2, b2)
+	cMean7 := math.Pow((c1+c2)/2, 7)
+	g := 0.5 * (1 - math.Sqrt(cMean7/(cMean7+math.Pow(25, 7))))
+
+	a1p := a1 * (1 + g)
+	a2p := a2 * (1 + g)
+	c1p := math.Hypot(a1p, b1)
+	c2p := math.Hypot(a2p, b2)
+
+	hue := func(a, b float64) float64 {
+		if a == 0 && b == 0 {
+			return 0
+		}
+		h := math.Atan2(b, a) * 180 / math.Pi
+		if h < 0 {
+			h += 360
+		}
+		return h
 	}
-	if b > 0.0031308 {
-		b = 1.055*math.Pow(b, 1/2.4) - 0.055
-	} else {
-		b = 12.92 * b
+	h1p := hue(a1p, b1)
+	h2p := hue(a2p, b2)
+
+	dLp := l2 - l1
+	dCp := c2p - c1p
+
+	var dhp float64
+	if c1p*c2p != 0 {
+		dhp = h2p - h1p
+		if dhp > 180 {
+			dhp -= 360
+		} else if dhp < -180 {
+			dhp += 360
+		}
+	}
+	dHp := 2 * math.Sqrt(c1p*c2p) * math.Sin(dhp/2*math.Pi/180)
+
+	lMean := (l1 + l2) / 2
+	cMeanP := (c1p + c2p) / 2
+
+	hMean := h1p + h2p
+	if c1p*c2p != 0 {
+		if math.Abs(h1p-h2p) > 180 {
+			if hMean < 360 {
+				hMean += 360
+			} else {
+				hMean -= 360
+			}
+		}
+		hMean /= 2
 	}
 
-	return []int{
-		int(math.Ceil(math.Max(0, math.Min(1, r)) * 255)),
-		int(math.Ceil(math.Max(0, math.Min(1, g)) * 255)),
-		int(math.Ceil(math.Max(0, math.Min(1, b)) * 255)),
+	t := 1 - 0.17*math.Cos((hMean-30)*math.Pi/180) +
+		0.24*math.Cos(2*hMean*math.Pi/180) +
+		0.32*math.Cos((3*hMean+6)*math.Pi/180) -
+		0.20*math.Cos((4*hMean-63)*math.Pi/180)
+
+	lMean50 := (lMean - 50) * (lMean - 50)
+	sl := 1 + 0.015*lMean50/math.Sqrt(20+lMean50)
+	sc := 1 + 0.045*cMeanP
+	sh := 1 + 0.015*cMeanP*t
+
+	dTheta := 30 * math.Exp(-math.Pow((hMean-275)/25, 2))
+	cMeanP7 := math.Pow(cMeanP, 7)
+	rc := 2 * math.Sqrt(cMeanP7/(cMeanP7+math.Pow(25, 7)))
+	rt := -math.Sin(2*dTheta*math.Pi/180) * rc
+
+	return math.Sqrt(
+		math.Pow(dLp/sl, 2) +
+			math.Pow(dCp/sc, 2) +
+			math.Pow(dHp/sh, 2) +
+			rt*(dCp/sc)*(dHp/sh),
+	)
+}
+
+// LabColor is a named LAB color value of a color library
+type LabColor struct {
+	Name string
+	Lab  []float64
+}
+
+// Match is a library color with its distance to the requested color
+type Match struct {
+	Name   string
+	DeltaE float64
+}
+
+// Nearest returns up to n library colors closest to the LAB color value, ordered by CIEDE2000 distance
+func Nearest(lab []float64, library []LabColor, n int) []Match {
+	matches := make([]Match, 0, len(library))
+	for _, color := range library {
+		matches = append(matches, Match{
+			Name:   color.Name,
+			DeltaE: DeltaE2000(lab, color.Lab),
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].DeltaE < matches[j].DeltaE
+	})
+	if n < len(matches) {
+		matches = matches[:n]
 	}
+	return matches
 }
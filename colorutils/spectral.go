@@ -0,0 +1,88 @@
+package dzi
+
+import (
+	"errors"
+	"math"
+)
+
+// Spectral tables from 380 to 780 nm with 10 nm step
+const (
+	spectralStart = 380
+	spectralStep  = 10
+)
+
+// cie1931 CIE 1931 2° standard observer color matching functions x̄, ȳ, z̄
+var cie1931 = [][3]float64{
+	{0.001368, 0.000039, 0.006450}, {0.004243, 0.000120, 0.020050}, {0.014310, 0.000396, 0.067850},
+	{0.043510, 0.001210, 0.207400}, {0.134380, 0.004000, 0.645600}, {0.283900, 0.011600, 1.385600},
+	{0.348280, 0.023000, 1.747060}, {0.336200, 0.038000, 1.772110}, {0.290800, 0.060000, 1.669200},
+	{0.195360, 0.090980, 1.287640}, {0.095640, 0.139020, 0.812950}, {0.032010, 0.208020, 0.465180},
+	{0.004900, 0.323000, 0.272000}, {0.009300, 0.503000, 0.158200}, {0.063270, 0.710000, 0.078250},
+	{0.165500, 0.862000, 0.042160}, {0.290400, 0.954000, 0.020300}, {0.433450, 0.994950, 0.008750},
+	{0.594500, 0.995000, 0.003900}, {0.762100, 0.952000, 0.002100}, {0.916300, 0.870000, 0.001650},
+	{1.026300, 0.757000, 0.001100}, {1.062200, 0.631000, 0.000800}, {1.002600, 0.503000, 0.000340},
+	{0.854450, 0.381000, 0.000190}, {0.642400, 0.265000, 0.000050}, {0.447900, 0.175000, 0.000020},
+	{0.283500, 0.107000, 0}, {0.164900, 0.061000, 0}, {0.087400, 0.032000, 0},
+	{0.046770, 0.017000, 0}, {0.022700, 0.008210, 0}, {0.011359, 0.004102, 0},
+	{0.005790, 0.002091, 0}, {0.002899, 0.001047, 0}, {0.001440, 0.000520, 0},
+	{0.000690, 0.000249, 0}, {0.000332, 0.000120, 0}, {0.000166, 0.000060, 0},
+	{0.000083, 0.000030, 0}, {0.000042, 0.000015, 0},
+}
+
+// illuminantD50 CIE D50 relative spectral power distribution
+var illuminantD50 = []float64{
+	24.49, 29.87, 49.31, 56.51, 60.03, 57.82, 74.82, 87.25, 90.61, 91.37,
+	95.11, 91.96, 95.72, 96.61, 97.13, 102.10, 100.75, 102.32, 100.00, 97.74,
+	98.92, 93.50, 97.69, 99.27, 99.04, 95.72, 98.86, 95.67, 98.19, 103.00,
+	99.13, 87.38, 91.60, 92.89, 76.85, 86.51, 92.58, 78.23, 57.69, 82.92,
+	78.27,
+}
+
+// spectralWeights return D50 weighted color matching functions at the wavelength, tables are
+// interpolated linearly, wavelengths out of the tables have no weight
+func spectralWeights(wavelength float64) [3]float64 {
+	pos := (wavelength - spectralStart) / spectralStep
+	if pos < 0 || pos > float64(len(cie1931)-1) {
+		return [3]float64{}
+	}
+	idx := int(pos)
+	next := min(idx+1, len(cie1931)-1)
+	t := pos - float64(idx)
+
+	spd := illuminantD50[idx]*(1-t) + illuminantD50[next]*t
+	var result [3]float64
+	for i := range result {
+		result[i] = spd * (cie1931[idx][i]*(1-t) + cie1931[next][i]*t)
+	}
+	return result
+}
+
+// Spectrum2lab converts reflectance spectrum to LAB (D50, 2° observer). Values are reflectance
+// factors 0-1 from startWL nm with increment nm step. White point is integrated over the same
+// wavelengths, so a perfect reflector is L=100 even when the spectrum is shorter than the tables.
+func Spectrum2lab(values []float64, startWL, increment float64) ([]float64, error) {
+	if len(values) == 0 || increment <= 0 {
+		return nil, errors.New("empty spectrum")
+	}
+	var xyz, white [3]float64
+	for idx, value := range values {
+		weights := spectralWeights(startWL + float64(idx)*increment)
+		for i := range weights {
+			xyz[i] += value * weights[i]
+			white[i] += weights[i]
+		}
+	}
+	if white[0] == 0 || white[1] == 0 || white[2] == 0 {
+		return nil, errors.New("spectrum is out of visible range")
+	}
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
+	}
+	x, y, z := f(xyz[0]/white[0]), f(xyz[1]/white[1]), f(xyz[2]/white[2])
+
+	return []float64{116*y - 16, 500 * (x - y), 200 * (y - z)}, nil
+}
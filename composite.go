@@ -0,0 +1,161 @@
+package dzi
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// excludeInks rebuild page composite without inks of excluded roles. Process inks are joined from B-W
+// separations into CMYK and converted with the input profile as the rendered composite, the other spot
+// plates are multiplied over it, so only spot inks are approximated. Composite stays untouched when page
+// has no excluded inks, otherwise excluded roles are recorded for the manifest.
+func excludeInks(page *pageInfo, roles []InkRole, bwFolder string, c *Config) error {
+	if page.ColorMode != ColorModeCMYK {
+		return nil
+	}
+
+	composite := pageComposite(page)
+	var excluded bool
+	process := make(map[string]*Swatch)
+	plates := make([]*Swatch, 0)
+	for _, swatch := range page.Swatches {
+		switch {
+		case !swatch.NeedMate:
+			continue
+		case slices.Contains(roles, swatch.Role):
+			excluded = true
+		case swatch.Type == CmykComponent:
+			process[strings.ToLower(swatch.Name)] = swatch
+		default:
+			plates = append(plates, swatch)
+		}
+	}
+	if composite == nil || !excluded {
+		return nil
+	}
+
+	st := time.Now()
+	log.Printf("[>] Rebuild composite page %d without %v", page.PageNumber, roles)
+	defer func() {
+		log.Printf("[<] Rebuild composite page %d, at %s", page.PageNumber, time.Since(st))
+	}()
+
+	ref, err := processComposite(page, composite, process, bwFolder, c)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+	if err = multiplyPlates(ref, plates); err != nil {
+		return err
+	}
+
+	outputFilepath := path.Join(path.Dir(composite.Filepath), fmt.Sprintf("%s.png", composite.Filename()))
+	if err = toPng(ref, outputFilepath); err != nil {
+		return err
+	}
+	if outputFilepath != composite.Filepath {
+		if err = os.Remove(composite.Filepath); err != nil {
+			return err
+		}
+	}
+	composite.Filepath = outputFilepath
+	composite.ExcludedRoles = roles
+
+	return nil
+}
+
+// processComposite join B-W separations of process inks into CMYK image and convert it to sRGB
+// with the input profile of the page. Missing process inks are left empty.
+func processComposite(page *pageInfo, composite *Swatch, process map[string]*Swatch, bwFolder string, c *Config) (*vips.ImageRef, error) {
+	bands := make([]*vips.ImageRef, len(processInks))
+	defer func() {
+		for _, band := range bands {
+			if band != nil {
+				band.Close()
+			}
+		}
+	}()
+
+	var width, height int
+	for idx, ink := range processInks {
+		swatch, ok := process[ink]
+		if !ok {
+			continue
+		}
+		band, err := vips.LoadImageFromFile(bwFilepath(swatch, bwFolder), nil)
+		if err != nil {
+			return nil, err
+		}
+		bands[idx] = band
+		if band.Bands() > 1 {
+			if err = band.ExtractBand(0, 1); err != nil {
+				return nil, err
+			}
+		}
+		// B-W separations are white where there is no ink
+		if err = band.Invert(); err != nil {
+			return nil, err
+		}
+		width, height = band.Width(), band.Height()
+	}
+	if width == 0 {
+		// All process inks are excluded, size is taken from the rendered composite
+		ref, err := vips.LoadImageFromFile(composite.Filepath, nil)
+		if err != nil {
+			return nil, err
+		}
+		width, height = ref.Width(), ref.Height()
+		ref.Close()
+	}
+	for idx := range bands {
+		if bands[idx] != nil {
+			continue
+		}
+		band, err := vips.Black(width, height)
+		if err != nil {
+			return nil, err
+		}
+		bands[idx] = band
+	}
+
+	joined, err := bands[0].Copy()
+	if err != nil {
+		return nil, err
+	}
+	defer joined.Close()
+	if err = joined.BandJoin(bands[1:]...); err != nil {
+		return nil, err
+	}
+	ref, err := joined.CopyChangingInterpretation(vips.InterpretationCMYK)
+	if err != nil {
+		return nil, err
+	}
+	if err = ref.TransformICCProfileWithFallback(c.ICCProfileFilepath, page.cmykProfile()); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	return ref, nil
+}
+
+// multiplyPlates blend colorized separations over the target with multiply mode
+func multiplyPlates(target *vips.ImageRef, plates []*Swatch) error {
+	for _, plate := range plates {
+		ref, err := vips.LoadImageFromFile(plate.Filepath, nil)
+		if err != nil {
+			return err
+		}
+		err = target.Composite(ref, vips.BlendModeMultiply, 0, 0)
+		ref.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package dzi
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"time"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// Color vision deficiency simulations
+const (
+	CVDProtanopia   = "protanopia"
+	CVDDeuteranopia = "deuteranopia"
+	CVDTritanopia   = "tritanopia"
+)
+
+// cvdMatrices Machado, Oliveira and Fernandes (2009) simulation matrices for severity 1.0,
+// applied to linear RGB
+var cvdMatrices = map[string][][]float64{
+	CVDProtanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	CVDDeuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	CVDTritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// makeCVD add simulated color vision deficiency renditions of the page composite as extra channels
+func makeCVD(pages []*pageInfo, c *Config) error {
+	for _, simulation := range c.CVDSimulations {
+		if _, ok := cvdMatrices[simulation]; !ok {
+			return fmt.Errorf("unknown CVD simulation %q", simulation)
+		}
+	}
+
+	st := time.Now()
+	log.Println("[>] Make CVD simulations")
+	defer func() {
+		log.Println("[<] Make CVD simulations, at", time.Since(st))
+	}()
+
+	for _, page := range pages {
+		composite := pageComposite(page)
+		if composite == nil {
+			continue
+		}
+		for _, simulation := range c.CVDSimulations {
+			if err := pageCVD(page, composite, simulation, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func pageCVD(page *pageInfo, composite *Swatch, simulation string, c *Config) error {
+	ref, err := vips.LoadImageFromFile(composite.Filepath, nil)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	if ref.ColorSpace() == vips.InterpretationCMYK {
+		if err = ref.TransformICCProfileWithFallback(c.ICCProfileFilepath, page.cmykProfile()); err != nil {
+			return err
+		}
+	} else if err = ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
+		return err
+	}
+	if ref.HasAlpha() {
+		if err = ref.Flatten(&vips.Color{R: 255, G: 255, B: 255}); err != nil {
+			return err
+		}
+	}
+
+	if err = ref.ToColorSpace(vips.InterpretationScRGB); err != nil {
+		return err
+	}
+	if err = ref.Recomb(cvdMatrices[simulation]); err != nil {
+		return err
+	}
+	if err = ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
+		return err
+	}
+
+	opsName := fmt.Sprintf("CVD_%s", simulation)
+	outputFilepath := path.Join(path.Dir(composite.Filepath), fmt.Sprintf("%s(%s).png", composite.Filename(), opsName))
+	if err = toPng(ref, outputFilepath); err != nil {
+		return err
+	}
+
+	page.Swatches = append(page.Swatches, &Swatch{
+		Filepath:   outputFilepath,
+		Name:       fmt.Sprintf("CVD %s", simulation),
+		OpsName:    opsName,
+		Type:       Final,
+		Variant:    VariantCVD,
+		Simulation: simulation,
+	})
+	return nil
+}
@@ -0,0 +1,105 @@
+package dzi
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// eskoBooks map Esko ink book code to the format of the library color name, %s is the Esko ink name (egname).
+// Codes are compared in lower case without "_", "-" and spaces, so "hks_k" and "HKSK" are the same book.
+var eskoBooks = map[string]string{
+	// Pantone Matching System
+	"pms1000c": "PANTONE %s C",
+	"pms1000u": "PANTONE %s U",
+	"pms1000m": "PANTONE %s M",
+	"pms1000":  "PANTONE %s C",
+	// Pantone Goe
+	"goec": "PANTONE %s C",
+	"goeu": "PANTONE %s U",
+	// Pantone metallic and premium metallic
+	"pmetc":  "PANTONE %s C",
+	"ppremc": "PANTONE %s C",
+	// Pantone pastels and neons
+	"ppasc": "PANTONE %s C",
+	"ppasu": "PANTONE %s U",
+	// Pantone Extended Gamut
+	"pxgc": "PANTONE %s XGC",
+	// HKS
+	"hksk": "HKS %s K",
+	"hksn": "HKS %s N",
+	"hkse": "HKS %s E",
+	"hksz": "HKS %s Z",
+	// Toyo
+	"toyo":   "TOYO %s",
+	"toyo94": "TOYO %s",
+	"toyocf": "TOYO CF%s",
+	// DIC
+	"dic":  "DIC %s",
+	"dicc": "DIC %s",
+	"dicn": "DIC %s",
+}
+
+// eskoBookName return library color name for Esko book code and ink name
+func eskoBookName(book, egname string) (string, bool) {
+	if egname == "" {
+		return "", false
+	}
+	code := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(book))
+	format, ok := eskoBooks[code]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(format, egname), true
+}
+
+// esko2swatch convert Esko metadata to Swatch
+func esko2swatch(ink eskoInk) Swatch {
+	var swatchName = ink.Name
+	if name, ok := eskoBookName(ink.Book, ink.EGName); ok {
+		swatchName = name
+	}
+
+	var swatchType = SpotComponent
+	if ink.Type == "process" {
+		swatchType = CmykComponent
+	}
+
+	// Ink type of Ink Manager is more specific than the type of the ink book
+	role := eskoInkRole(ink.InkType)
+	if role == "" {
+		role = eskoInkRole(ink.Type)
+	}
+
+	var R = 255 * ink.R / 1
+	var G = 255 * ink.G / 1
+	var B = 255 * ink.B / 1
+
+	return Swatch{
+		Filepath: "",
+		Name:     swatchName,
+		RBG:      fmt.Sprintf("#%02x%02x%02x", int(math.Round(R)), int(math.Round(G)), int(math.Round(B))),
+		Type:     swatchType,
+		NeedMate: true,
+		Role:     role,
+		Source:   SourceEsko,
+		Book:     ink.Book,
+		Ink:      eskoInkAttributes(ink),
+	}
+}
+
+// eskoInkAttributes return press attributes of the ink or nil when metadata has none
+func eskoInkAttributes(ink eskoInk) *InkAttributes {
+	attrs := &InkAttributes{
+		Type:     strings.ToLower(ink.InkType),
+		Opacity:  ink.Opacity,
+		Sequence: ink.Sequence,
+		Angle:    ink.Angle,
+		Ruling:   ink.Ruling,
+		DotShape: ink.DotShape,
+	}
+	if *attrs == (InkAttributes{}) {
+		return nil
+	}
+	return attrs
+}
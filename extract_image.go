@@ -5,12 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"strings"
 
 	"github.com/davidbyttow/govips/v2/vips"
 )
 
-func extractImage(filename, basename, _output string, c *Config) ([]*pageInfo, error) {
+func extractImage(filename, basename, _output string, inkRules []*inkRule, resolver *colorResolver, c *Config) ([]*pageInfo, error) {
 	pages := make([]*pageInfo, 1)
 
 	ref, err := vips.LoadImageFromFile(filename, nil)
@@ -152,13 +151,22 @@ func extractImage(filename, basename, _output string, c *Config) ([]*pageInfo, e
 				return nil, err
 			}
 
+			var rgb, library string
+			var source = SourceImage
+			if m := confident(resolver.books.Lookup(swatchName)); m != nil {
+				rgb, library, source = m.Color.Hex(), m.Book.Title, librarySource(m.Book)
+			}
+
 			info.Swatches = append(info.Swatches, &Swatch{
 				Filepath: outputPath,
 				Name:     swatchName,
 				OpsName:  swatchName,
-				RBG:      CMYK[strings.ToLower(swatchName)],
+				RBG:      rgb,
+				Library:  library,
+				Source:   source,
 				Type:     CmykComponent,
 				NeedMate: true,
+				Role:     classifyInk(swatchName, "", inkRules),
 			})
 			band.Close()
 		}
@@ -187,3 +195,14 @@ func toPng(ref *vips.ImageRef, output string) error {
 
 	return os.WriteFile(output, buffer, 0644)
 }
+
+func toJpeg(ref *vips.ImageRef, output string, quality int) error {
+	params := vips.NewJpegExportParams()
+	params.Quality = quality
+	buffer, _, err := ref.ExportJpeg(params)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(output, buffer, 0644)
+}
@@ -1,15 +1,13 @@
 package dzi
 
 import (
-	"encoding/xml"
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"os"
-	"slices"
 	"strings"
 
-	dzi "github.com/brandquad/dzi/colorutils"
 	poppler2 "github.com/johbar/go-poppler"
 )
 
@@ -29,115 +27,35 @@ func extractText(filepath string, pageNum int) (string, error) {
 	return strings.Join(result, ""), err
 }
 
-func getPageInfo(doc *poppler2.Document, pageNum int) (*pageInfo, map[string]Swatch, error) {
-	xmlString := doc.Info().Metadata
-
-	var d pdfMeta
-	var eg pdfEgMeta
-
-	if strings.TrimSpace(xmlString) != "" {
-		func() {
-			defer func() {
-				if err := recover(); err != nil {
-					log.Printf("Error extracting page info, %d", pageNum+1)
-					xmlString = ""
-				}
-			}()
-
-			decoder := xml.NewDecoder(strings.NewReader(xmlString))
-			if err := decoder.Decode(&d); err != nil {
-				panic(err)
-			}
-			decoder = xml.NewDecoder(strings.NewReader(xmlString))
-			if err := decoder.Decode(&eg); err != nil {
-				panic(err)
-			}
-		}()
-	}
-
-	swatchMap := make(map[string]Swatch)
-	//if len(eg.Inks) > 0 && len(d.SwatchGroups) == 0 {
-	if len(eg.Inks) > 0 {
-		for _, e := range eg.Inks {
-			sw := esko2swatch(e.Name, e.EGName, e.Type, e.Book, e.R, e.G, e.B)
-			swatchMap[sw.Name] = sw
-		}
-	}
-
-	if len(swatchMap) == 0 {
-		if len(d.SwatchGroups) > 0 {
-			for idx, s := range d.SwatchGroups {
-
-				codePoints := []rune(s.SwatchName) // Output: [A r t i o s _ È „ O ‚ I ‡]
-				decoded := ""
-				for _, codePoint := range codePoints {
-					if codePoint >= 0x0400 && codePoint <= 0x04FF { // Cyrillic range
-						decoded += string(codePoint)
-					} else {
-						decoded += string(codePoint)
-					}
-				}
-
-				var exists bool
-
-				if !slices.Contains(d.PlateNames, s.SwatchName) {
-					exists = true
-				} else {
-					for _, pn := range d.PlateNames {
-						sName := strings.TrimSpace(s.SwatchName)
-						pn = strings.TrimSpace(pn)
-						exists = pn == sName || strings.HasPrefix(sName, pn) || strings.HasSuffix(sName, pn)
-						if exists {
-							break
-						}
-					}
-				}
-
-				if !exists {
-					d.SwatchGroups = append(d.SwatchGroups[:idx], d.SwatchGroups[idx+1:]...)
-					continue
-				}
-
-				var c []int
-				switch strings.ToUpper(s.Mode) {
-				case "LAB":
-					c = dzi.Lab2rgb([]float64{s.L, s.A, s.B})
-				case "RGB":
-					c = []int{s.Red, s.Green, s.Blue}
-				case "CMYK":
-					c = dzi.Cmyk2rgb([]float64{
-						s.Cyan,
-						s.Magenta,
-						s.Yellow,
-						s.Black},
-					)
-				}
-				if c != nil {
-					swatchMap[s.SwatchName] = Swatch{
-						Name: s.SwatchName,
-						RBG:  fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2]),
-						Type: SpotComponent,
-					}
-				}
-			}
+// getPageInfo return page info and inks of the page. Inks from page XMP override document XMP,
+// so colors of one page don't leak to another.
+func getPageInfo(docMeta *pdfMeta, docSwatches map[string]Swatch, pageNum int, pagePackets []string, resolver *colorResolver) (*pageInfo, map[string]Swatch, error) {
+	swatchMap := maps.Clone(docSwatches)
+	w, h, unit := docMeta.W, docMeta.H, docMeta.Unit
+
+	for _, packet := range pagePackets {
+		d, eg := parseXMP(packet)
+		maps.Copy(swatchMap, xmpSwatches(d, eg, resolver))
+		if d.W > 0 && d.H > 0 {
+			w, h, unit = d.W, d.H, d.Unit
 		}
 	}
 
 	return &pageInfo{
 		Prefix:     fmt.Sprintf("page_%d", pageNum),
 		PageNumber: pageNum,
-		Width:      d.W,
-		Height:     d.H,
-		Unit:       d.Unit,
+		Width:      w,
+		Height:     h,
+		Unit:       unit,
 		ColorMode:  ColorModeCMYK,
 		Swatches:   make([]*Swatch, 0),
 	}, swatchMap, nil
 }
 
-func extractPDF(filePath, baseName, outputFolder string, c *Config) ([]*pageInfo, error) {
+func extractPDF(filePath, baseName, outputFolder string, inkRules []*inkRule, resolver *colorResolver, c *Config) ([]*pageInfo, error) {
 
 	// Render pages
-	pagesSizes, spots, err := renderPdf(filePath, outputFolder, baseName, c)
+	pagesSizes, spots, err := renderPdf(filePath, outputFolder, baseName, resolver, c)
 	if err != nil {
 		return nil, err
 	}
@@ -147,13 +65,21 @@ func extractPDF(filePath, baseName, outputFolder string, c *Config) ([]*pageInfo
 		return nil, err
 	}
 
+	docMeta, docEg := parseXMP(gopopDoc.Info().Metadata)
+	docSwatches := xmpSwatches(docMeta, docEg, resolver)
+
+	pagesXMP, err := getPagesXMP(filePath)
+	if err != nil {
+		log.Printf("[!] Error reading pages XMP: %v", err)
+	}
+
 	pages := make([]*pageInfo, 0)
 	totalPages := gopopDoc.GetNPages()
 
 	for pageIndex := 1; pageIndex <= totalPages; pageIndex++ {
 
 		log.Printf("Processing page %d from %d", pageIndex, totalPages)
-		page, swatchMap, err := getPageInfo(gopopDoc, pageIndex)
+		page, swatchMap, err := getPageInfo(docMeta, docSwatches, pageIndex, pagesXMP[pageIndex], resolver)
 		if err != nil {
 			return nil, err
 		}
@@ -165,7 +91,7 @@ func extractPDF(filePath, baseName, outputFolder string, c *Config) ([]*pageInfo
 			page.TextContent = textContent
 		}
 
-		page, err = pageProcessing(outputFolder, page, swatchMap, spots[pageIndex])
+		page, err = pageProcessing(outputFolder, page, swatchMap, spots[pageIndex], inkRules, resolver)
 		if err != nil {
 			return nil, err
 		}
@@ -176,6 +102,8 @@ func extractPDF(filePath, baseName, outputFolder string, c *Config) ([]*pageInfo
 				page.Width = ps.WidthPt / pt2mm
 				page.Height = ps.HeightPt / pt2mm
 				page.Unit = "mm"
+				page.Layers = ps.Layers
+				page.InputProfile = resolver.outputIntent
 			}
 		}
 
@@ -185,7 +113,7 @@ func extractPDF(filePath, baseName, outputFolder string, c *Config) ([]*pageInfo
 	return pages, nil
 }
 
-func pageProcessing(outputFolder string, info *pageInfo, swatchMap map[string]Swatch, channels channelsMap) (*pageInfo, error) {
+func pageProcessing(outputFolder string, info *pageInfo, swatchMap map[string]Swatch, channels channelsMap, inkRules []*inkRule, resolver *colorResolver) (*pageInfo, error) {
 	//var spotsBackUpExists []string
 	//
 	//for k := range channels {
@@ -201,30 +129,82 @@ func pageProcessing(outputFolder string, info *pageInfo, swatchMap map[string]Sw
 		//var name = channel.Name()
 		//var filePath = path.Join(outputFolder, info.Prefix, channel.Name())
 
-		//swatchName := matchSwatch(name)
-
 		if _, err := os.Stat(channel.Filepath); errors.Is(err, os.ErrNotExist) {
 			log.Printf("[-] Skipping file %s", channel.Filepath)
 			continue
 		}
 
 		var rgbComponents = channel.RgbComponents
+		var library, libraryName = channel.Library, channel.LibraryName
+		var nameConfidence = channel.NameConfidence
+		var lab, cmyk = channel.Lab, channel.Cmyk
+		var source, book = channel.Source, ""
+		var group string
+		var tints []float64
+		var mixedInks []string
+		var ink *InkAttributes
+		var metaRole InkRole
 		if swatchMap != nil {
-			if v, ok := swatchMap[name]; ok {
+			if v, ok := lookupSwatchMap(swatchMap, name, resolver.books.Normalizer); ok {
 				rgbComponents = v.RBG
+				library, libraryName, nameConfidence = "", "", 0
+				lab = v.Lab
+				if v.Cmyk != nil {
+					cmyk = v.Cmyk
+				}
+				source, book = v.Source, v.Book
+				group, tints, mixedInks = v.Group, v.Tints, v.MixedInks
+				ink = v.Ink
+				metaRole = v.Role
 			}
 		}
+		// Customer libraries win over document metadata
+		if m := confident(resolver.books.LookupCustom(name)); m != nil {
+			rgbComponents = m.Color.Hex()
+			library, libraryName, nameConfidence = m.Book.Title, m.Color.Name, m.Confidence
+			lab = m.Color.Lab
+			if m.Color.CMYK != nil {
+				cmyk = m.Color.CMYK
+			}
+			source = SourceCustomer
+		}
 
 		swatchInfo := &Swatch{
-			Filepath: channel.Filepath,
-			OpsName:  channel.OpsName,
-			Name:     name,
-			NeedMate: true,
-			RBG:      rgbComponents,
+			Filepath:       channel.Filepath,
+			OpsName:        channel.OpsName,
+			Name:           name,
+			NeedMate:       true,
+			RBG:            rgbComponents,
+			Library:        library,
+			LibraryName:    libraryName,
+			NameConfidence: nameConfidence,
+			RawName:        channel.RawName,
+			Charset:        channel.Charset,
+			Lab:            roundComponents(lab),
+			Cmyk:           roundComponents(cmyk),
+			Source:         source,
+			Book:           book,
+			Group:          group,
+			Tints:          tints,
+			MixedInks:      mixedInks,
+			Ink:            ink,
+			Variant:        channel.Variant,
+			Overprint:      channel.Overprint,
+			Layers:         channel.Layers,
+			Profile:        channel.Profile,
 		}
-		if name == "Color" {
+		if name == "Color" || channel.IsColor {
 			swatchInfo.Type = Final
 			swatchInfo.NeedMate = false
+		} else {
+			swatchInfo.Type = swatchTypeByName(name)
+			swatchInfo.Role = classifyInk(name, metaRole, inkRules)
+			if swatchInfo.Lab == nil {
+				swatchInfo.Lab = roundComponents(hex2lab(swatchInfo.RBG))
+			}
+			if swatchInfo.Type == SpotComponent && swatchInfo.Lab != nil {
+				swatchInfo.Match = resolver.nearest(swatchInfo.Lab)
+			}
 		}
 
 		//if v, ok := channels[name]; ok {
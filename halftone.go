@@ -0,0 +1,334 @@
+package dzi
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"math"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidbyttow/govips/v2/vips"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// Dot shapes of halftone screen
+const (
+	DotRound   = "round"
+	DotSquare  = "square"
+	DotDiamond = "diamond"
+	DotLine    = "line"
+)
+
+// DefaultScreenRuling is the screen ruling in lines per inch used when Config.ScreenRuling is not set
+const DefaultScreenRuling = 150.0
+
+// defaultScreenAngles conventional angles of process inks, other inks use defaultSpotAngle
+var defaultScreenAngles = map[string]float64{
+	"cyan":    15,
+	"magenta": 75,
+	"yellow":  0,
+	"black":   45,
+}
+
+const defaultSpotAngle = 45.0
+
+// screenCellSize pixels of the screen cell used to build threshold image
+const screenCellSize = 32
+
+// minScreenCellPixels smallest screen cell at page DPI, screening is skipped for coarser pages
+const minScreenCellPixels = 2.0
+
+// Screen is a halftone screen of the ink
+type Screen struct {
+	Angle    float64 `json:"angle"`
+	Ruling   float64 `json:"ruling"`
+	DotShape string  `json:"dot_shape"`
+}
+
+// ScreenSetting is a per-job screen of the ink, unset values are taken from Esko metadata or defaults
+type ScreenSetting struct {
+	Ink      string
+	Angle    *float64
+	Ruling   *float64
+	DotShape string
+}
+
+// inkScreen return screen of the ink: per-job settings, then Esko ink attributes, then defaults
+func inkScreen(swatch *Swatch, c *Config) Screen {
+	screen := Screen{
+		Angle:    defaultSpotAngle,
+		Ruling:   c.ScreenRuling,
+		DotShape: dotShape(c.ScreenDotShape),
+	}
+	if screen.Ruling <= 0 {
+		screen.Ruling = DefaultScreenRuling
+	}
+	if angle, ok := defaultScreenAngles[strings.ToLower(swatch.Name)]; ok {
+		screen.Angle = angle
+	}
+
+	if ink := swatch.Ink; ink != nil {
+		if ink.Angle != nil {
+			screen.Angle = *ink.Angle
+		}
+		if ink.Ruling != nil && *ink.Ruling > 0 {
+			screen.Ruling = *ink.Ruling
+		}
+		if ink.DotShape != "" {
+			screen.DotShape = dotShape(ink.DotShape)
+		}
+	}
+
+	idx := slices.IndexFunc(c.ScreenSettings, func(s ScreenSetting) bool {
+		return strings.EqualFold(s.Ink, swatch.Name)
+	})
+	if idx != -1 {
+		setting := c.ScreenSettings[idx]
+		if setting.Angle != nil {
+			screen.Angle = *setting.Angle
+		}
+		if setting.Ruling != nil && *setting.Ruling > 0 {
+			screen.Ruling = *setting.Ruling
+		}
+		if setting.DotShape != "" {
+			screen.DotShape = dotShape(setting.DotShape)
+		}
+	}
+	return screen
+}
+
+// dotShape map dot shape name of Esko or settings to the supported shape, round is the default
+func dotShape(name string) string {
+	name = strings.ToLower(name)
+	switch {
+	case strings.Contains(name, "square"):
+		return DotSquare
+	case strings.Contains(name, "diamond"), strings.Contains(name, "ellip"):
+		return DotDiamond
+	case strings.Contains(name, "line"):
+		return DotLine
+	}
+	return DotRound
+}
+
+// dotArea return share of the screen cell covered by the dot which reaches the point (u, v),
+// coordinates are relative to the cell center from -0.5 to 0.5
+func dotArea(shape string, u, v float64) float64 {
+	switch shape {
+	case DotSquare:
+		s := 2 * max(math.Abs(u), math.Abs(v))
+		return s * s
+	case DotDiamond:
+		s := math.Abs(u) + math.Abs(v)
+		if s <= 0.5 {
+			return 2 * s * s
+		}
+		return 1 - 2*(1-s)*(1-s)
+	case DotLine:
+		return 2 * math.Abs(v)
+	}
+
+	// Round dot grows as circle and merges with neighbours after 78.5%
+	r := math.Hypot(u, v)
+	switch {
+	case r <= 0.5:
+		return math.Pi * r * r
+	case r < math.Sqrt2/2:
+		return math.Pi*r*r - 4*(r*r*math.Acos(0.5/r)-0.5*math.Sqrt(r*r-0.25))
+	}
+	return 1
+}
+
+// screenCell build threshold image of one screen cell, pixel value is the ink density
+// from which the pixel is inked
+func screenCell(shape string) (*vips.ImageRef, error) {
+	cell := image.NewGray(image.Rect(0, 0, screenCellSize, screenCellSize))
+	for y := 0; y < screenCellSize; y++ {
+		for x := 0; x < screenCellSize; x++ {
+			u := (float64(x)+0.5)/screenCellSize - 0.5
+			v := (float64(y)+0.5)/screenCellSize - 0.5
+			// Keep full density inked everywhere
+			threshold := min(math.Round(dotArea(shape, u, v)*255), 254)
+			cell.SetGray(x, y, color.Gray{Y: uint8(threshold)})
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, cell); err != nil {
+		return nil, err
+	}
+	return vips.NewImageFromBuffer(buffer.Bytes())
+}
+
+// screenThreshold build threshold image of the page size for the screen at page DPI
+func screenThreshold(screen Screen, width, height, dpi int) (*vips.ImageRef, error) {
+	ref, err := screenCell(screen.DotShape)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rotated screen must cover the page, so cells cover a square with side of width + height
+	cellPixels := float64(dpi) / screen.Ruling
+	cells := int(math.Ceil(float64(width+height)/cellPixels)) + 1
+	if err = ref.Replicate(cells, cells); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	if err = ref.Similarity(cellPixels/screenCellSize, screen.Angle, &vips.ColorRGBA{}, 0, 0, 0, 0); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	if err = ref.ExtractArea((ref.Width()-width)/2, (ref.Height()-height)/2, width, height); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	return ref, nil
+}
+
+// screenPlate load black-white separation, screen it and return multiply factor of the colorized plate:
+// 1 without ink, ink color in 0-1 with ink
+func screenPlate(bwPath string, ink colorful.Color, screen Screen, dpi int) (*vips.ImageRef, error) {
+	ref, err := vips.LoadImageFromFile(bwPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Bands() > 1 {
+		if err = ref.ExtractBand(0, 1); err != nil {
+			ref.Close()
+			return nil, err
+		}
+	}
+
+	threshold, err := screenThreshold(screen, ref.Width(), ref.Height(), dpi)
+	if err != nil {
+		ref.Close()
+		return nil, err
+	}
+	defer threshold.Close()
+	if err = threshold.Linear([]float64{-1}, []float64{0}); err != nil {
+		ref.Close()
+		return nil, err
+	}
+
+	// Density minus threshold is positive for inked pixels, scale and clip it to 0 or 255
+	if err = ref.Linear([]float64{-1}, []float64{255}); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	if err = ref.Add(threshold); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	if err = ref.Linear([]float64{1e6}, []float64{0}); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	if err = ref.Cast(vips.BandFormatUchar); err != nil {
+		ref.Close()
+		return nil, err
+	}
+
+	factor := []float64{-(1 - ink.R) / 255, -(1 - ink.G) / 255, -(1 - ink.B) / 255}
+	if err = ref.Linear(factor, []float64{1, 1, 1}); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	return ref, nil
+}
+
+// makeScreenedProof screen separations of CMYK pages and multiply them into "Screened proof" composite
+func makeScreenedProof(pages []*pageInfo, bwRoot string, c *Config) error {
+	st := time.Now()
+	log.Println("[>] Make screened proof")
+	defer func() {
+		log.Println("[<] Make screened proof, at", time.Since(st))
+	}()
+
+	for _, page := range pages {
+		if page.ColorMode != ColorModeCMYK {
+			continue
+		}
+		composite := pageComposite(page)
+		if composite == nil {
+			continue
+		}
+		if err := pageScreenedProof(page, composite, path.Join(bwRoot, page.Prefix), c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pageScreenedProof(page *pageInfo, composite *Swatch, bwFolder string, c *Config) error {
+	var out *vips.ImageRef
+	defer func() {
+		if out != nil {
+			out.Close()
+		}
+	}()
+
+	plates := make([]*Swatch, 0)
+	screens := make([]Screen, 0)
+	for _, swatch := range page.Swatches {
+		if !swatch.NeedMate || slices.Contains(c.ExcludeInkRoles, swatch.Role) {
+			continue
+		}
+		screen := inkScreen(swatch, c)
+		if float64(page.Dpi)/screen.Ruling < minScreenCellPixels {
+			log.Printf("[-] Screen %g lpi of %s is too fine for %d DPI, page %d skipped", screen.Ruling, swatch.Name, page.Dpi, page.PageNumber)
+			return nil
+		}
+		plates = append(plates, swatch)
+		screens = append(screens, screen)
+	}
+
+	for idx, swatch := range plates {
+		ink, err := colorful.Hex(swatch.RBG)
+		if err != nil {
+			return err
+		}
+		plate, err := screenPlate(bwFilepath(swatch, bwFolder), ink, screens[idx], page.Dpi)
+		if err != nil {
+			return err
+		}
+		swatch.Screen = &screens[idx]
+		if out == nil {
+			out = plate
+			continue
+		}
+		err = out.Multiply(plate)
+		plate.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if out == nil {
+		return nil
+	}
+
+	if err := out.Linear([]float64{255}, []float64{0}); err != nil {
+		return err
+	}
+	if err := out.Cast(vips.BandFormatUchar); err != nil {
+		return err
+	}
+	outputFilepath := path.Join(path.Dir(composite.Filepath), fmt.Sprintf("%s(Screened).png", composite.Filename()))
+	if err := toPng(out, outputFilepath); err != nil {
+		return err
+	}
+
+	page.Swatches = append(page.Swatches, &Swatch{
+		Filepath: outputFilepath,
+		Name:     "Screened proof",
+		OpsName:  "Screened",
+		Type:     Final,
+		Variant:  VariantScreened,
+	})
+	return nil
+}
@@ -0,0 +1,202 @@
+package dzi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// iiifTarget is a folder of IIIF images and the URL prefix of their ids
+type iiifTarget struct {
+	Root string
+	ID   string
+}
+
+// newIIIFTarget return IIIF target of the asset subfolder, ids are prefixed with IIIFBaseURL.
+// IIIF Image API requires absolute ids, so the base URL must be absolute.
+func newIIIFTarget(tmp, folder string, assetId int, c *Config) (*iiifTarget, error) {
+	if u, err := url.Parse(c.IIIFBaseURL); err != nil || !u.IsAbs() || u.Host == "" {
+		return nil, fmt.Errorf("IIIF base URL %q is not an absolute URL", c.IIIFBaseURL)
+	}
+	return &iiifTarget{
+		Root: path.Join(tmp, folder),
+		ID:   fmt.Sprintf("%s/%d/%s", strings.TrimSuffix(c.IIIFBaseURL, "/"), assetId, folder),
+	}, nil
+}
+
+type iiifTile struct {
+	Width        int   `json:"width"`
+	Height       int   `json:"height"`
+	ScaleFactors []int `json:"scaleFactors"`
+}
+
+type iiifSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// iiifInfo is info.json of IIIF Image API 3.0 level 0 image
+type iiifInfo struct {
+	Context          string     `json:"@context"`
+	ID               string     `json:"id"`
+	Type             string     `json:"type"`
+	Protocol         string     `json:"protocol"`
+	Profile          string     `json:"profile"`
+	Width            int        `json:"width"`
+	Height           int        `json:"height"`
+	Tiles            []iiifTile `json:"tiles"`
+	Sizes            []iiifSize `json:"sizes,omitempty"`
+	PreferredFormats []string   `json:"preferredFormats,omitempty"`
+	ExtraFormats     []string   `json:"extraFormats,omitempty"`
+}
+
+// iiifFormat return IIIF format name of the tile format
+func iiifFormat(format string) string {
+	if format == "jpeg" {
+		return "jpg"
+	}
+	return format
+}
+
+// saveIIIF write IIIF level 0 static image: info.json and tiles at {region}/{w},{h}/0/default.{format}
+// for each scale factor down to the one whose single tile covers the image. Such scales are also
+// written as full/{w},{h} and the full size as full/max, so viewers can request whole image.
+func saveIIIF(ref *vips.ImageRef, target *iiifTarget, prefix, name, format, setting string, c *Config) (string, error) {
+	tileSize, err := strconv.Atoi(c.TileSize)
+	if err != nil || tileSize <= 0 {
+		return "", fmt.Errorf("tile size %q is not correct", c.TileSize)
+	}
+	opts, err := parseTileSetting(setting)
+	if err != nil {
+		return "", err
+	}
+
+	folder := path.Join(target.Root, prefix, name)
+	if err = os.RemoveAll(folder); err != nil {
+		return "", err
+	}
+	width, height := ref.Width(), ref.Height()
+	ext := iiifFormat(format)
+
+	info := &iiifInfo{
+		Context:  "http://iiif.io/api/image/3/context.json",
+		ID:       fmt.Sprintf("%s/%s/%s", target.ID, prefix, name),
+		Type:     "ImageService3",
+		Protocol: "http://iiif.io/api/image",
+		Profile:  "level0",
+		Width:    width,
+		Height:   height,
+	}
+	if ext != "jpg" {
+		info.PreferredFormats = []string{ext}
+		info.ExtraFormats = []string{ext}
+	}
+
+	scaleFactors := make([]int, 0)
+	for scale := 1; ; scale *= 2 {
+		scaleFactors = append(scaleFactors, scale)
+		if err = saveIIIFScale(ref, folder, ext, opts, scale, tileSize); err != nil {
+			return "", fmt.Errorf("iiif scale %d of %s: %w", scale, folder, err)
+		}
+		if tileSize*scale >= max(width, height) {
+			break
+		}
+	}
+	info.Tiles = []iiifTile{{Width: tileSize, Height: tileSize, ScaleFactors: scaleFactors}}
+
+	// Full image sizes from the smallest one
+	for idx := len(scaleFactors) - 1; idx >= 0; idx-- {
+		scale := scaleFactors[idx]
+		w, h := (width+scale-1)/scale, (height+scale-1)/scale
+		if w > tileSize || h > tileSize {
+			break
+		}
+		info.Sizes = append(info.Sizes, iiifSize{Width: w, Height: h})
+	}
+
+	buffer, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(path.Join(folder, "info.json"), buffer, 0644); err != nil {
+		return "", err
+	}
+	return folder, nil
+}
+
+func saveIIIFScale(ref *vips.ImageRef, folder, ext string, opts tileOptions, scale, tileSize int) error {
+	width, height := ref.Width(), ref.Height()
+	levelRef, err := ref.Copy()
+	if err != nil {
+		return err
+	}
+	defer levelRef.Close()
+
+	levelWidth, levelHeight := (width+scale-1)/scale, (height+scale-1)/scale
+	if scale > 1 {
+		hScale := float64(levelWidth) / float64(width)
+		vScale := float64(levelHeight) / float64(height)
+		if err = levelRef.ResizeWithVScale(hScale, vScale, vips.KernelLinear); err != nil {
+			return err
+		}
+		levelWidth, levelHeight = levelRef.Width(), levelRef.Height()
+	}
+
+	for top := 0; top < levelHeight; top += tileSize {
+		for left := 0; left < levelWidth; left += tileSize {
+			w, h := min(tileSize, levelWidth-left), min(tileSize, levelHeight-top)
+
+			tile, err := levelRef.Copy()
+			if err != nil {
+				return err
+			}
+			err = tile.ExtractArea(left, top, w, h)
+			var buffer []byte
+			if err == nil {
+				buffer, err = encodeTile(tile, ext, opts)
+			}
+			tile.Close()
+			if err != nil {
+				return err
+			}
+
+			// Region is in full image coordinates
+			x, y := left*scale, top*scale
+			region := fmt.Sprintf("%d,%d,%d,%d", x, y, min(tileSize*scale, width-x), min(tileSize*scale, height-y))
+			regions := []string{region}
+			if w == levelWidth && h == levelHeight {
+				regions = append(regions, "full")
+			}
+			sizes := []string{fmt.Sprintf("%d,%d", w, h)}
+			if scale == 1 {
+				sizes = append(sizes, "max")
+			}
+
+			for _, region := range regions {
+				for _, size := range sizes {
+					if region != "full" && size == "max" {
+						continue
+					}
+					if err = writeIIIFTile(folder, region, size, ext, buffer); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func writeIIIFTile(folder, region, size, ext string, buffer []byte) error {
+	tileFolder := path.Join(folder, region, size, "0")
+	if err := os.MkdirAll(tileFolder, DefaultFolderPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(tileFolder, fmt.Sprintf("default.%s", ext)), buffer, 0644)
+}
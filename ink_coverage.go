@@ -0,0 +1,143 @@
+package dzi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"log"
+	"math"
+	"os"
+	"path"
+	"time"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// coverageBins count of tint histogram bins: no ink and ten 10% steps
+const coverageBins = 11
+
+type InkCoverage struct {
+	Percent     float64   `json:"percent"`
+	AreaMM2     float64   `json:"area_mm2"`
+	PageAreaMM2 float64   `json:"page_area_mm2"`
+	Histogram   []float64 `json:"histogram"`
+}
+
+// channelHistogram return 256 bins histogram of the grayscale separation
+func channelHistogram(bwPath string) ([]uint32, error) {
+	ref, err := vips.LoadImageFromFile(bwPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer ref.Close()
+
+	if ref.Bands() > 1 {
+		if err = ref.ExtractBand(0, 1); err != nil {
+			return nil, err
+		}
+	}
+	if ref.BandFormat() != vips.BandFormatUchar {
+		if err = ref.Cast(vips.BandFormatUchar); err != nil {
+			return nil, err
+		}
+	}
+	if err = ref.HistogramFind(); err != nil {
+		return nil, err
+	}
+	if err = ref.Cast(vips.BandFormatUint); err != nil {
+		return nil, err
+	}
+	buffer, err := ref.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	histogram := make([]uint32, 256)
+	if err = binary.Read(bytes.NewReader(buffer), binary.NativeEndian, histogram); err != nil {
+		return nil, err
+	}
+	return histogram, nil
+}
+
+// channelCoverage compute ink coverage of the grayscale separation, where white means no ink
+func channelCoverage(bwPath string, dpi int) (*InkCoverage, error) {
+	histogram, err := channelHistogram(bwPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var total, inked, tintSum float64
+	bins := make([]float64, coverageBins)
+	for value, count := range histogram {
+		tint := float64(255-value) / 255 * 100
+		total += float64(count)
+		tintSum += tint * float64(count)
+		if value < 255 {
+			inked += float64(count)
+		}
+		bins[int(math.Ceil(tint/10))] += float64(count)
+	}
+	if total == 0 {
+		return &InkCoverage{Histogram: bins}, nil
+	}
+
+	pixelArea := math.Pow(25.4/float64(dpi), 2)
+	for idx := range bins {
+		bins[idx] = bins[idx] / total * 100
+	}
+
+	return &InkCoverage{
+		Percent:     tintSum / total,
+		AreaMM2:     inked * pixelArea,
+		PageAreaMM2: total * pixelArea,
+		Histogram:   bins,
+	}, nil
+}
+
+// makeInkCoverage compute coverage for each separation of CMYK pages
+func makeInkCoverage(pages []*pageInfo, bwRoot string) error {
+	st := time.Now()
+	log.Println("[>] Make ink coverage")
+	defer func() {
+		log.Println("[<] Make ink coverage, at", time.Since(st))
+	}()
+
+	for _, page := range pages {
+		if page.ColorMode != ColorModeCMYK || page.Dpi == 0 {
+			continue
+		}
+		for _, swatch := range page.Swatches {
+			if !swatch.NeedMate {
+				continue
+			}
+			bwPath := bwFilepath(swatch, path.Join(bwRoot, page.Prefix))
+			if _, err := os.Stat(bwPath); os.IsNotExist(err) {
+				continue
+			}
+			coverage, err := channelCoverage(bwPath, page.Dpi)
+			if err != nil {
+				return err
+			}
+			swatch.PageCoverage = coverage
+		}
+	}
+	return nil
+}
+
+// sumCoverage aggregate coverage over pages, percent and histogram are weighted by page area
+func sumCoverage(total, page *InkCoverage) *InkCoverage {
+	if total == nil {
+		total = &InkCoverage{Histogram: make([]float64, coverageBins)}
+	}
+	pageArea := total.PageAreaMM2 + page.PageAreaMM2
+	if pageArea == 0 {
+		return total
+	}
+
+	total.Percent = (total.Percent*total.PageAreaMM2 + page.Percent*page.PageAreaMM2) / pageArea
+	for idx := range total.Histogram {
+		total.Histogram[idx] = (total.Histogram[idx]*total.PageAreaMM2 + page.Histogram[idx]*page.PageAreaMM2) / pageArea
+	}
+	total.AreaMM2 += page.AreaMM2
+	total.PageAreaMM2 = pageArea
+	return total
+}
@@ -0,0 +1,102 @@
+package dzi
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+)
+
+type InkRole string
+
+const (
+	InkRolePrinting  InkRole = "printing"
+	InkRoleTechnical InkRole = "technical"
+	InkRoleVarnish   InkRole = "varnish"
+	InkRoleWhite     InkRole = "white"
+	InkRoleBraille   InkRole = "braille"
+)
+
+var processInks = []string{"cyan", "magenta", "yellow", "black"}
+
+type inkRule struct {
+	Pattern string  `json:"pattern"`
+	Role    InkRole `json:"role"`
+	re      *regexp.Regexp
+}
+
+// defaultInkRules used after rules from InkRulesFilepath and Esko metadata
+var defaultInkRules = []*inkRule{
+	{Pattern: `(?i)die\s*-?\s*(line|cut)|cutter|cutting|crease|creasing|stanz|perforation|dimensions?|technical|non[-\s]?print`, Role: InkRoleTechnical},
+	{Pattern: `(?i)braille|blindenschrift`, Role: InkRoleBraille},
+	{Pattern: `(?i)varnish|lacquer|\black\b|coating`, Role: InkRoleVarnish},
+	{Pattern: `(?i)\b(white|weiss)\b|weiß`, Role: InkRoleWhite},
+}
+
+func init() {
+	for _, rule := range defaultInkRules {
+		rule.re = regexp.MustCompile(rule.Pattern)
+	}
+}
+
+// loadInkRules read JSON array of {"pattern": "...", "role": "..."} rules.
+// Empty filepath means no custom rules.
+func loadInkRules(filepath string) ([]*inkRule, error) {
+	rules := make([]*inkRule, 0)
+	if filepath == "" {
+		return rules, nil
+	}
+
+	buffer, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(buffer, &rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.re, err = regexp.Compile(rule.Pattern); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+// classifyInk detect ink role by custom rules, then by role from Esko metadata, then by default name rules
+func classifyInk(name string, metaRole InkRole, rules []*inkRule) InkRole {
+	for _, rule := range rules {
+		if rule.re.MatchString(name) {
+			return rule.Role
+		}
+	}
+	if metaRole != "" {
+		return metaRole
+	}
+	for _, rule := range defaultInkRules {
+		if rule.re.MatchString(name) {
+			return rule.Role
+		}
+	}
+	return InkRolePrinting
+}
+
+// eskoInkRole map Esko ink type to ink role
+func eskoInkRole(egtype string) InkRole {
+	switch strings.ToLower(egtype) {
+	case "technical":
+		return InkRoleTechnical
+	case "varnish":
+		return InkRoleVarnish
+	}
+	return ""
+}
+
+// swatchTypeByName detect swatch type for separations by name
+func swatchTypeByName(name string) SwatchType {
+	for _, ink := range processInks {
+		if strings.EqualFold(name, ink) {
+			return CmykComponent
+		}
+	}
+	return SpotComponent
+}
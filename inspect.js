@@ -0,0 +1,153 @@
+// Optional content (PDF layers) inspection and rendering over MuPDF
+//
+// usage: mutool run inspect.js layers <file.pdf>
+//        mutool run inspect.js layered <file.pdf> <visible> <output.pdf>
+//        mutool run inspect.js metadata <file.pdf>
+//
+// layers prints one line per page and optional content group used on that page:
+//        Page <num>\t<layer index>\t<on|off>\t<layer name>
+// layered writes a copy of the document where the default optional content configuration switches on
+// only the layers from <visible>, so any renderer shows the same layer state.
+// <visible> is a comma separated list of layer indexes, "-" switches all layers off.
+// metadata prints XMP packets of each page (page /Metadata stream and XMP in /PieceInfo private data):
+//        %%Page <num>
+//        <xmp>
+//        %%EndPage
+
+var command = scriptArgs[0];
+var doc = Document.openDocument(scriptArgs[1]);
+var pdf = doc.asPDF ? doc.asPDF() : doc;
+
+function layerIndexes() {
+	var indexes = {};
+	var count = pdf.countLayers();
+	for (var i = 0; i < count; i++) {
+		var name = pdf.getLayerName(i);
+		if (indexes[name] === undefined) {
+			indexes[name] = i;
+		}
+	}
+	return indexes;
+}
+
+function collectGroups(obj, names) {
+	if (!obj || obj.isNull()) {
+		return;
+	}
+	if (obj.isArray()) {
+		for (var i = 0; i < obj.length; i++) {
+			collectGroups(obj.get(i), names);
+		}
+		return;
+	}
+	if (!obj.isDictionary()) {
+		return;
+	}
+	var type = obj.get("Type");
+	if (!type.isNull() && type.asName() === "OCMD") {
+		collectGroups(obj.get("OCGs"), names);
+		return;
+	}
+	var name = obj.get("Name");
+	if (!name.isNull()) {
+		names[name.asString()] = true;
+	}
+}
+
+function pageGroups(pageObj) {
+	var names = {};
+	var resources = pageObj.get("Resources");
+	if (resources.isNull()) {
+		return names;
+	}
+	var properties = resources.get("Properties");
+	if (properties.isDictionary()) {
+		properties.forEach(function (value) {
+			collectGroups(value, names);
+		});
+	}
+	var xobjects = resources.get("XObject");
+	if (xobjects.isDictionary()) {
+		xobjects.forEach(function (value) {
+			collectGroups(value.get("OC"), names);
+		});
+	}
+	return names;
+}
+
+function isXMP(text) {
+	return text.indexOf("<x:xmpmeta") >= 0 || text.indexOf("<?xpacket") >= 0;
+}
+
+function printXMP(pageNum, obj) {
+	if (!obj || obj.isNull() || !obj.isStream()) {
+		return;
+	}
+	var text = obj.readStream().asString();
+	if (isXMP(text)) {
+		print("%%Page " + pageNum);
+		print(text);
+		print("%%EndPage");
+	}
+}
+
+if (command === "layers") {
+	var indexes = layerIndexes();
+	var pages = pdf.countPages();
+	for (var p = 0; p < pages; p++) {
+		var names = pageGroups(pdf.findPage(p));
+		for (var name in names) {
+			var index = indexes[name];
+			if (index === undefined) {
+				continue;
+			}
+			print("Page " + (p + 1) + "\t" + index + "\t" + (pdf.isLayerVisible(index) ? "on" : "off") + "\t" + name);
+		}
+	}
+} else if (command === "layered") {
+	var visible = {};
+	if (scriptArgs[2] !== "-") {
+		scriptArgs[2].split(",").forEach(function (index) {
+			visible[parseInt(index, 10)] = true;
+		});
+	}
+	// Layer indexes of MuPDF follow the /OCGs array of /OCProperties
+	var ocProperties = pdf.getTrailer().get("Root").get("OCProperties");
+	var ocgs = ocProperties.get("OCGs");
+	var on = pdf.newArray();
+	var off = pdf.newArray();
+	for (var i = 0; i < ocgs.length; i++) {
+		if (visible[i] === true) {
+			on.push(ocgs.get(i));
+		} else {
+			off.push(ocgs.get(i));
+		}
+	}
+	var config = ocProperties.get("D");
+	if (!config.isDictionary()) {
+		config = pdf.newDictionary();
+		ocProperties.put("D", config);
+	}
+	config.put("BaseState", pdf.newName("ON"));
+	config.put("ON", on);
+	config.put("OFF", off);
+	// Usage application would switch layers again by the intent of the renderer
+	config.delete("AS");
+	pdf.save(scriptArgs[3], "");
+} else if (command === "metadata") {
+	var pages = pdf.countPages();
+	for (var p = 0; p < pages; p++) {
+		var pageObj = pdf.findPage(p);
+		printXMP(p + 1, pageObj.get("Metadata"));
+		var pieceInfo = pageObj.get("PieceInfo");
+		if (pieceInfo.isDictionary()) {
+			pieceInfo.forEach(function (value) {
+				if (value.isDictionary()) {
+					printXMP(p + 1, value.get("Private"));
+				}
+			});
+		}
+	}
+} else {
+	throw new Error("unknown command: " + command);
+}
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"fmt"
 	"github.com/alitto/pond"
+	"github.com/davidbyttow/govips/v2/vips"
 	"log"
 	"os"
 	"path"
@@ -11,10 +12,29 @@ import (
 	"time"
 )
 
-func makeDZI(pool *pond.WorkerPool, isBW bool, pages []*pageInfo, income, outcome string, c *Config) error {
+// VipsConcurrency return number of libvips threads per operation, it must be passed to vips.Startup.
+// Not set value means one thread, so MaxCpuCount pyramids are built in parallel as separate dzsave runs did.
+func VipsConcurrency(c *Config) int {
+	if c.VipsConcurrency > 0 {
+		return c.VipsConcurrency
+	}
+	return 1
+}
+
+// newDZIPool return worker pool for DZI tasks. Each task runs libvips with VipsConcurrency threads,
+// so workers and libvips threads share MaxCpuCount budget.
+func newDZIPool(c *Config) *pond.WorkerPool {
+	panicHandler := func(p interface{}) {
+		fmt.Printf("[!] Task panicked: %v", p)
+	}
+	workers := max(c.MaxCpuCount/VipsConcurrency(c), 1)
+	return pond.New(workers, 1000, pond.MinWorkers(workers), pond.PanicHandler(panicHandler))
+}
+
+// makeDZI make DZI of color or B-W channels, IIIF level 0 images are written too when iiif is set
+func makeDZI(pool *pond.WorkerPool, isBW bool, pages []*pageInfo, outcome string, iiif *iiifTarget, c *Config) error {
 
 	for padeIdx, page := range pages {
-		sourceFolder := path.Join(income, page.Prefix)
 		outcomeFolder := path.Join(outcome, page.Prefix)
 		if err := os.MkdirAll(outcomeFolder, DefaultFolderPerm); err != nil {
 			return err
@@ -38,65 +58,69 @@ func makeDZI(pool *pond.WorkerPool, isBW bool, pages []*pageInfo, income, outcom
 
 				sourceFileExt := path.Ext(filepath)
 				sourceBasename := strings.TrimSuffix(strings.TrimPrefix(filepath, sourceFilePath), sourceFileExt)[1:]
-				dziPath := path.Join(outcomeFolder, sourceBasename)
-
-				if sourceFileExt == ".tiff" && !isBW {
+				dziPath := fmt.Sprintf("%s.zip", path.Join(outcomeFolder, sourceBasename))
 
-					log.Printf("[*] Convert %s to SRGB with profile !!!! %s", filepath, c.ICCProfileFilepath)
+				defer func() {
+					log.Printf("[*] dzsave for %s , at %s", filepath, time.Since(st))
+				}()
 
-					jpegFileName := fmt.Sprintf("%s.jpeg", sourceBasename)
-					jpegPath := path.Join(sourceFolder, jpegFileName)
+				ref, err := vips.LoadImageFromFile(filepath, nil)
+				if err != nil {
+					panic(err)
+				}
+				defer ref.Close()
 
+				// CMYK TIFF is replaced with converted JPEG in channels, covers and stored channels use it
+				var convertedPath string
+				if sourceFileExt == ".tiff" && !isBW {
+					inputProfile := page.cmykProfile()
 					if c.DebugMode {
-						log.Printf("[D] vips icc_transform %s %s[Q=95] %s", filepath, jpegPath, c.ICCProfileFilepath)
+						log.Printf("[D] Convert %s to SRGB with profile %s, input profile %s", filepath, c.ICCProfileFilepath, inputProfile)
 					}
-					log.Println("[D] Try convert")
-					_, err := execCmd("vips", "icc_transform", filepath, fmt.Sprintf("%s[Q=95]", jpegPath), c.ICCProfileFilepath)
-					if err != nil {
-						log.Println("[D] Convert error. ")
-						log.Printf("[!] Error icc_transform. Just skip and jpegsave.")
-						if _, err = execCmd("vips", "jpegsave", filepath, jpegPath); err != nil {
+					if err = ref.TransformICCProfileWithFallback(c.ICCProfileFilepath, inputProfile); err != nil {
+						log.Printf("[!] Error icc_transform %s: %v. Just convert to sRGB.", filepath, err)
+						if err = ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
 							panic(err)
 						}
-						//panic(err)
 					}
-
-					if err = os.Remove(filepath); err != nil {
+					convertedPath = path.Join(sourceFilePath, fmt.Sprintf("%s.jpeg", sourceBasename))
+					if err = toJpeg(ref, convertedPath, 95); err != nil {
 						panic(err)
 					}
-					filepath = jpegPath
-					swatch.Filepath = jpegPath
 				}
 
-				defer func() {
-					log.Printf("[*] dzsave for %s , at %s", filepath, time.Since(st))
-				}()
-
-				dziPath = fmt.Sprintf("%s.zip", dziPath)
-				if _, err := execCmd("vips", "dzsave",
-					filepath,
-					dziPath,
-					"--strip",
-					"--container=zip",
-					"--suffix",
-					fmt.Sprintf(".%s%s", c.TileFormat, c.TileSetting),
-					fmt.Sprintf("--vips-concurrency=%d", c.MaxCpuCount),
-					fmt.Sprintf("--tile-size=%s", c.TileSize),
-					fmt.Sprintf("--overlap=%s", c.Overlap)); err != nil {
+				if err = saveDZI(ref, dziPath, c.TileFormat, c.TileSetting, c); err != nil {
 					panic(err)
 				}
 
+				var iiifPath string
+				if iiif != nil {
+					if iiifPath, err = saveIIIF(ref, iiif, page.Prefix, sourceBasename, c.TileFormat, c.TileSetting, c); err != nil {
+						panic(err)
+					}
+				}
+
 				rangesData, err := ranges(dziPath)
 				if err != nil {
 					panic(err)
 				}
 
+				// Source TIFF is read lazily, so it is removed only when all images are written
+				if convertedPath != "" {
+					if err = os.Remove(filepath); err != nil {
+						panic(err)
+					}
+					pages[padeIdx].Swatches[swatchIdx].Filepath = convertedPath
+				}
+
 				if isBW {
 					pages[padeIdx].Swatches[swatchIdx].DziBWPath = dziPath
 					pages[padeIdx].Swatches[swatchIdx].DziBWRanges = rangesData
+					pages[padeIdx].Swatches[swatchIdx].IIIFBWPath = iiifPath
 				} else {
 					pages[padeIdx].Swatches[swatchIdx].DziColorPath = dziPath
 					pages[padeIdx].Swatches[swatchIdx].DziColorRanges = rangesData
+					pages[padeIdx].Swatches[swatchIdx].IIIFColorPath = iiifPath
 				}
 			})
 		}
@@ -105,6 +129,58 @@ func makeDZI(pool *pond.WorkerPool, isBW bool, pages []*pageInfo, income, outcom
 	return nil
 }
 
+// makeAlphaDZI make DZI of alpha plates, tiles keep alpha channel so PNG is used unless tile format is WebP
+func makeAlphaDZI(pool *pond.WorkerPool, pages []*pageInfo, outcome string, c *Config) error {
+	format := alphaTileFormat(c)
+	var setting string
+	if format == c.TileFormat {
+		setting = c.TileSetting
+	}
+
+	for _, page := range pages {
+		outcomeFolder := path.Join(outcome, page.Prefix)
+		if err := os.MkdirAll(outcomeFolder, DefaultFolderPerm); err != nil {
+			return err
+		}
+
+		for _, swatch := range page.Swatches {
+			if swatch.AlphaFilepath == "" {
+				continue
+			}
+			pool.Submit(func() {
+				st := time.Now()
+				dziPath := path.Join(outcomeFolder, fmt.Sprintf("%s.zip", swatch.Filename()))
+				defer func() {
+					log.Printf("[*] dzsave alpha for %s , at %s", swatch.AlphaFilepath, time.Since(st))
+				}()
+
+				ref, err := vips.LoadImageFromFile(swatch.AlphaFilepath, nil)
+				if err != nil {
+					panic(err)
+				}
+				defer ref.Close()
+				if err = saveDZI(ref, dziPath, format, setting, c); err != nil {
+					panic(err)
+				}
+				rangesData, err := ranges(dziPath)
+				if err != nil {
+					panic(err)
+				}
+				swatch.DziAlphaPath = dziPath
+				swatch.DziAlphaRanges = rangesData
+			})
+		}
+	}
+	return nil
+}
+
+func alphaTileFormat(c *Config) string {
+	if c.TileFormat == "webp" {
+		return "webp"
+	}
+	return "png"
+}
+
 func ranges(zipfile string) (map[string]ZipRange, error) {
 	result := make(map[string]ZipRange)
 	reader, err := zip.OpenReader(zipfile)
@@ -0,0 +1,27 @@
+package dzi
+
+import "testing"
+
+func TestNewDZIPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		vips    int
+		workers int
+	}{
+		{"default", Config{MaxCpuCount: 8}, 1, 8},
+		{"shared budget", Config{MaxCpuCount: 8, VipsConcurrency: 4}, 4, 2},
+		{"vips over budget", Config{MaxCpuCount: 4, VipsConcurrency: 8}, 8, 1},
+		{"no cpu count", Config{}, 1, 1},
+	}
+	for _, tt := range tests {
+		if got := VipsConcurrency(&tt.config); got != tt.vips {
+			t.Errorf("%s: VipsConcurrency = %d, want %d", tt.name, got, tt.vips)
+		}
+		pool := newDZIPool(&tt.config)
+		if got := pool.MaxWorkers(); got != tt.workers {
+			t.Errorf("%s: %d workers, want %d", tt.name, got, tt.workers)
+		}
+		pool.StopAndWait()
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"os"
 	"path"
 	"strconv"
@@ -11,7 +12,7 @@ import (
 	"time"
 )
 
-func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, filename, tmpRoot, rangesPath string, startTime time.Time) (*Manifest, error) {
+func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, filename, tmpRoot, rangesPath, outputIntent string, startTime time.Time) (*Manifest, error) {
 	st := time.Now()
 	log.Println("[>] Make manifest.json")
 	defer func() {
@@ -31,14 +32,23 @@ func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, file
 
 		for _, s := range page.Swatches {
 
-			var needAppend = true
-			for _, sd := range swatches {
-				if sd.Name == s.Name {
-					needAppend = false
+			// Composite variants and analysis channels are page channels only, not inks of the asset
+			if s.Variant == "" && s.Type != Analysis {
+				var needAppend = true
+				for _, sd := range swatches {
+					if sd.Name == s.Name {
+						needAppend = false
+						if s.PageCoverage != nil {
+							sd.Coverage = sumCoverage(sd.Coverage, s.PageCoverage)
+						}
+					}
+				}
+				if needAppend {
+					if s.PageCoverage != nil {
+						s.Coverage = sumCoverage(nil, s.PageCoverage)
+					}
+					swatches = append(swatches, s)
 				}
-			}
-			if needAppend {
-				swatches = append(swatches, s)
 			}
 			dziColorPath := strings.TrimPrefix(s.DziColorPath, tmpRoot)
 			dziBWPath := strings.TrimPrefix(s.DziBWPath, tmpRoot)
@@ -59,15 +69,51 @@ func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, file
 				bwRangesPath = ""
 			}
 
+			var alphaRangesPath string
+			if len(s.DziAlphaRanges) > 0 {
+				alphaRangesPath = path.Join(rangesPath, fmt.Sprintf("alpha_%d_%s.json", page.PageNumber, s.OpsName))
+				buffer, err := json.Marshal(s.DziAlphaRanges)
+				if err != nil {
+					return nil, err
+				}
+				if err := os.WriteFile(alphaRangesPath, buffer, 0644); err != nil {
+					return nil, err
+				}
+				alphaRangesPath = strings.TrimPrefix(alphaRangesPath, tmpRoot)
+			}
+
 			channelsArr = append(channelsArr, s.Name)
 			channels = append(channels, &ChannelV4{
-				Name:         s.Name,
-				DziColorPath: dziColorPath,
-				DziBWPath:    dziBWPath,
-				LeadPath:     strings.TrimPrefix(s.LeadPath, tmpRoot),
-				CoverPath:    strings.TrimPrefix(s.CoverPath, tmpRoot),
-				ColorRanges:  s.DziColorRanges,
-				BwRangesPath: bwRangesPath,
+				Name:            s.Name,
+				DziColorPath:    dziColorPath,
+				DziBWPath:       dziBWPath,
+				LeadPath:        strings.TrimPrefix(s.LeadPath, tmpRoot),
+				CoverPath:       strings.TrimPrefix(s.CoverPath, tmpRoot),
+				ColorRanges:     s.DziColorRanges,
+				BwRangesPath:    bwRangesPath,
+				IIIFColorPath:   strings.TrimPrefix(s.IIIFColorPath, tmpRoot),
+				IIIFBWPath:      strings.TrimPrefix(s.IIIFBWPath, tmpRoot),
+				DziAlphaPath:    strings.TrimPrefix(s.DziAlphaPath, tmpRoot),
+				AlphaRangesPath: alphaRangesPath,
+				Variant:         s.Variant,
+				Overprint:       s.Overprint,
+				Layers:          s.Layers,
+				Profile:         s.Profile,
+				Simulation:      s.Simulation,
+				Screen:          s.Screen,
+				ExcludedRoles:   s.ExcludedRoles,
+				Role:            s.Role,
+				Coverage:        s.PageCoverage,
+				Lab:             s.Lab,
+				Cmyk:            s.Cmyk,
+				Source:          s.Source,
+				Library:         s.Library,
+				LibraryName:     s.LibraryName,
+				RawName:         s.RawName,
+				Charset:         s.Charset,
+				NameConfidence:  math.Round(s.NameConfidence*100) / 100,
+				Ink:             s.Ink,
+				Match:           s.Match,
 			})
 		}
 
@@ -80,12 +126,22 @@ func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, file
 			hStr = fmt.Sprintf("%f", page.Height)
 		}
 
+		var layers []*PageLayer
+		for _, layer := range page.Layers {
+			layers = append(layers, &PageLayer{
+				Name:    layer.Name,
+				Visible: layer.Visible,
+			})
+		}
+
 		manifestPages = append(manifestPages, &Page{
 			PageNum:     page.PageNumber,
 			Channels:    channelsArr,
 			ChannelsV4:  channels,
 			Mode:        string(page.ColorMode),
 			TextContent: page.TextContent,
+			Layers:      layers,
+			MaxTAC:      page.MaxTAC,
 			Size: DziSize{
 				Width:  wStr,
 				Height: hStr,
@@ -96,16 +152,23 @@ func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, file
 
 	}
 
+	var alphaFormat string
+	if c.AlphaChannels {
+		alphaFormat = alphaTileFormat(c)
+	}
+
 	var manifest = &Manifest{
-		Version:        "5",
+		Version:        "6",
 		ID:             strconv.Itoa(assetId),
 		TimestampStart: startTime.Format("2006-01-02 15:04:05"),
 		TimestampEnd:   time.Now().Format("2006-01-02 15:04:05"),
+		Revision:       1,
 		Source:         url,
 		Filename:       filename,
 		Basename:       basename,
 		TileSize:       c.TileSize,
 		TileFormat:     c.TileFormat,
+		AlphaFormat:    alphaFormat,
 		CoverHeight:    c.CoverHeight,
 		Overlap:        c.Overlap,
 		Mode:           "Perpage",
@@ -113,6 +176,16 @@ func makeManifest(pages []*pageInfo, assetId int, c *Config, url, basename, file
 		Swatches:       swatches,
 		SplitChannels:  c.SplitChannels,
 		Overprint:      c.Overprint,
+		OverprintModes: append([]string{c.Overprint}, overprintVariants(c)...),
+		Substrate:      substrateInfo(c),
+	}
+
+	if outputIntent != "" {
+		manifest.OutputIntent = strings.TrimPrefix(outputIntent, tmpRoot)
+	}
+
+	if c.TotalAreaCoverage {
+		manifest.InkLimit = inkLimit(c)
 	}
 
 	return manifest, nil
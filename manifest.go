@@ -15,13 +15,41 @@ type DziSize struct {
 }
 
 type ChannelV4 struct {
-	Name         string              `json:"name"`
-	DziColorPath string              `json:"dzi_color_path"`
-	DziBWPath    string              `json:"dzi_bw_path"`
-	LeadPath     string              `json:"lead_path"`
-	CoverPath    string              `json:"cover_path"`
-	ColorRanges  map[string]ZipRange `json:"color_ranges"`
-	BwRangesPath string              `json:"bw_ranges_path"`
+	Name            string              `json:"name"`
+	DziColorPath    string              `json:"dzi_color_path"`
+	DziBWPath       string              `json:"dzi_bw_path"`
+	LeadPath        string              `json:"lead_path"`
+	CoverPath       string              `json:"cover_path"`
+	ColorRanges     map[string]ZipRange `json:"color_ranges"`
+	BwRangesPath    string              `json:"bw_ranges_path"`
+	IIIFColorPath   string              `json:"iiif_color_path,omitempty"`
+	IIIFBWPath      string              `json:"iiif_bw_path,omitempty"`
+	DziAlphaPath    string              `json:"dzi_alpha_path,omitempty"`
+	AlphaRangesPath string              `json:"alpha_ranges_path,omitempty"`
+	Variant         string              `json:"variant,omitempty"`
+	Overprint       string              `json:"overprint,omitempty"`
+	Layers          []string            `json:"layers,omitempty"`
+	Profile         string              `json:"profile,omitempty"`
+	Simulation      string              `json:"simulation,omitempty"`
+	Screen          *Screen             `json:"screen,omitempty"`
+	ExcludedRoles   []InkRole           `json:"excluded_roles,omitempty"`
+	Role            InkRole             `json:"role,omitempty"`
+	Coverage        *InkCoverage        `json:"coverage,omitempty"`
+	Lab             []float64           `json:"lab,omitempty"`
+	Cmyk            []float64           `json:"cmyk,omitempty"`
+	Source          SwatchSource        `json:"source,omitempty"`
+	Library         string              `json:"library,omitempty"`
+	LibraryName     string              `json:"library_name,omitempty"`
+	NameConfidence  float64             `json:"name_confidence,omitempty"`
+	RawName         string              `json:"raw_name,omitempty"`
+	Charset         string              `json:"charset,omitempty"`
+	Ink             *InkAttributes      `json:"ink,omitempty"`
+	Match           *SwatchMatch        `json:"match,omitempty"`
+}
+
+type PageLayer struct {
+	Name    string `json:"name"`
+	Visible bool   `json:"visible"`
 }
 
 type Page struct {
@@ -31,25 +59,35 @@ type Page struct {
 	TextContent string       `json:"text_content"`
 	ChannelsV4  []*ChannelV4 `json:"channels_v4"`
 	Channels    []string     `json:"channels"`
+	Layers      []*PageLayer `json:"layers,omitempty"`
+	MaxTAC      float64      `json:"max_tac,omitempty"`
 }
 
 type Manifest struct {
-	Version        string    `json:"version"`
-	ID             string    `json:"id"`
-	TimestampStart string    `json:"timestamp_start"`
-	TimestampEnd   string    `json:"timestamp_end"`
-	Source         string    `json:"source"`
-	Filename       string    `json:"filename"`
-	Basename       string    `json:"basename"`
-	TileSize       string    `json:"tile_size"`
-	TileFormat     string    `json:"tile_format"`
-	CoverHeight    string    `json:"cover_height"`
-	Overlap        string    `json:"overlap"`
-	Mode           string    `json:"mode"`
-	Pages          []*Page   `json:"pages"`
-	Swatches       []*Swatch `json:"swatches,omitempty"`
-	SplitChannels  bool      `json:"split_channels"`
-	Overprint      string    `json:"overprint"`
+	Version         string       `json:"version"`
+	ID              string       `json:"id"`
+	TimestampStart  string       `json:"timestamp_start"`
+	TimestampEnd    string       `json:"timestamp_end"`
+	TimestampUpdate string       `json:"timestamp_update,omitempty"`
+	Revision        int          `json:"revision,omitempty"`
+	Source          string       `json:"source"`
+	Filename        string       `json:"filename"`
+	Basename        string       `json:"basename"`
+	TileSize        string       `json:"tile_size"`
+	TileFormat      string       `json:"tile_format"`
+	AlphaFormat     string       `json:"alpha_tile_format,omitempty"`
+	CoverHeight     string       `json:"cover_height"`
+	Overlap         string       `json:"overlap"`
+	Mode            string       `json:"mode"`
+	Pages           []*Page      `json:"pages"`
+	Swatches        []*Swatch    `json:"swatches,omitempty"`
+	SplitChannels   bool         `json:"split_channels"`
+	Overprint       string       `json:"overprint"`
+	OverprintModes  []string     `json:"overprint_modes,omitempty"`
+	Substrate       *Substrate   `json:"substrate,omitempty"`
+	InkLimit        float64      `json:"ink_limit,omitempty"`
+	OutputIntent    string       `json:"output_intent,omitempty"`
+	SwatchFiles     *SwatchFiles `json:"swatch_files,omitempty"`
 }
 
 func (b *Manifest) toMM(unit string, x float64) float64 {
@@ -1,21 +1,26 @@
 package dzi
 
-import "regexp"
+import (
+	"maps"
+	"slices"
 
-var mathRegExp *regexp.Regexp
+	"github.com/brandquad/dzi/assets"
+)
 
-func init() {
-	regExp, err := regexp.Compile(`\((.*)\)`)
-	if err != nil {
-		panic(err)
+// lookupSwatchMap find swatch from document metadata by channel name, exact name first,
+// then by normalized name, so "PANTONE 185 C" channel finds "PANTONE 185 CVC" swatch
+func lookupSwatchMap(swatchMap map[string]Swatch, name string, normalizer *assets.Normalizer) (Swatch, bool) {
+	if v, ok := swatchMap[name]; ok {
+		return v, true
 	}
-	mathRegExp = regExp
-}
-
-func matchSwatch(in string) string {
-	match := mathRegExp.FindStringSubmatch(in)
-	if match == nil {
-		return ""
+	key, _ := normalizer.Key(name)
+	if key == "" {
+		return Swatch{}, false
+	}
+	for _, swatchName := range slices.Sorted(maps.Keys(swatchMap)) {
+		if k, _ := normalizer.Key(swatchName); k == key {
+			return swatchMap[swatchName], true
+		}
 	}
-	return match[1]
+	return Swatch{}, false
 }
@@ -0,0 +1,447 @@
+package dzi
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/davidbyttow/govips/v2/vips"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// MixSource open files of processed assets by manifest paths, like "/12345/dzi_bw/page_1/Cyan.zip"
+type MixSource interface {
+	Open(name string) (io.ReaderAt, int64, error)
+}
+
+// DirSource is MixSource over local folder with asset folders, the parent of "<assetId>" folder
+type DirSource string
+
+func (d DirSource) Open(name string) (io.ReaderAt, int64, error) {
+	file, err := os.Open(path.Join(string(d), name))
+	if err != nil {
+		return nil, 0, err
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+	return file, stat.Size(), nil
+}
+
+// MixChannel is a separation of the mix with opacity from 0 to 1
+type MixChannel struct {
+	Name    string
+	Opacity float64
+}
+
+// FullInks return mix channels with full opacity
+func FullInks(names ...string) []MixChannel {
+	channels := make([]MixChannel, len(names))
+	for idx, name := range names {
+		channels[idx] = MixChannel{Name: name, Opacity: 1}
+	}
+	return channels
+}
+
+// InkMixer composite black-white separations (channels_bw) of the processed asset on demand.
+// Plates are colorized by swatch colors and multiplied over white paper in ink order,
+// ink opacity from Esko metadata covers inks printed before.
+// Mixer is safe for concurrent use, libvips must be started by the caller.
+type InkMixer struct {
+	manifest *Manifest
+	source   MixSource
+
+	mu       sync.Mutex
+	archives map[string]*mixArchive
+}
+
+// mixArchive index of DZI zip, tiles by "<level>/<col>_<row>.<format>" names
+type mixArchive struct {
+	closer     io.Closer
+	descriptor []byte
+	tiles      map[string]*zip.File
+}
+
+type mixPlate struct {
+	archive    *mixArchive
+	color      colorful.Color
+	opacity    float64
+	inkOpacity float64
+	sequence   *int
+}
+
+func NewInkMixer(manifest *Manifest, source MixSource) *InkMixer {
+	return &InkMixer{
+		manifest: manifest,
+		source:   source,
+		archives: make(map[string]*mixArchive),
+	}
+}
+
+// Close release opened archives
+func (m *InkMixer) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for name, archive := range m.archives {
+		if archive.closer != nil {
+			errs = append(errs, archive.closer.Close())
+		}
+		delete(m.archives, name)
+	}
+	return errors.Join(errs...)
+}
+
+// Tile return encoded composite tile of the page in manifest tile format
+func (m *InkMixer) Tile(pageNum int, channels []MixChannel, level, col, row int) ([]byte, error) {
+	plates, err := m.plates(pageNum, channels)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := m.mixTile(plates, m.tileName(level, col, row))
+	if err != nil {
+		return nil, err
+	}
+	defer ref.Close()
+	return m.encodeTile(ref)
+}
+
+// Composite write composite DZI zip of the page to output file and return ranges of its tiles.
+// Tiles are stored without compression in the same layout as vips dzsave zip container.
+func (m *InkMixer) Composite(pageNum int, channels []MixChannel, output string) (map[string]ZipRange, error) {
+	plates, err := m.plates(pageNum, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	basename := strings.TrimSuffix(filepath.Base(output), filepath.Ext(output))
+	writer := zip.NewWriter(file)
+	if err = storeZipEntry(writer, path.Join(basename, basename+".dzi"), plates[0].archive.descriptor); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(plates[0].archive.tiles))
+	for name := range plates[0].archive.tiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		ref, err := m.mixTile(plates, name)
+		if err != nil {
+			return nil, err
+		}
+		buffer, err := m.encodeTile(ref)
+		ref.Close()
+		if err != nil {
+			return nil, err
+		}
+		if err = storeZipEntry(writer, path.Join(basename, basename+"_files", name), buffer); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	if err = file.Close(); err != nil {
+		return nil, err
+	}
+	return ranges(output)
+}
+
+// plates return plates of requested channels in ink order: by Esko sequence when all plates have it,
+// otherwise in page channels order
+func (m *InkMixer) plates(pageNum int, channels []MixChannel) ([]*mixPlate, error) {
+	page := m.manifest.GetPageByNum(pageNum)
+	if page == nil {
+		return nil, fmt.Errorf("page %d not found", pageNum)
+	}
+	if page.Mode != string(ColorModeCMYK) {
+		return nil, fmt.Errorf("page %d: ink mixer supports CMYK pages only, got %q", pageNum, page.Mode)
+	}
+	if len(channels) == 0 {
+		return nil, fmt.Errorf("page %d: no channels to mix", pageNum)
+	}
+
+	plates := make([]*mixPlate, 0, len(channels))
+	sequenced := true
+	for _, channel := range page.ChannelsV4 {
+		idx := slices.IndexFunc(channels, func(c MixChannel) bool { return c.Name == channel.Name })
+		if idx == -1 {
+			continue
+		}
+		if channel.DziBWPath == "" || channel.Variant != "" {
+			return nil, fmt.Errorf("page %d: channel %s has no separation", pageNum, channel.Name)
+		}
+
+		swatchIdx := slices.IndexFunc(m.manifest.Swatches, func(s *Swatch) bool { return s.Name == channel.Name })
+		if swatchIdx == -1 {
+			return nil, fmt.Errorf("page %d: no swatch for channel %s", pageNum, channel.Name)
+		}
+		color, err := colorful.Hex(m.manifest.Swatches[swatchIdx].RBG)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", channel.Name, err)
+		}
+
+		archive, err := m.archive(channel.DziBWPath)
+		if err != nil {
+			return nil, err
+		}
+
+		plate := &mixPlate{
+			archive: archive,
+			color:   color,
+			opacity: min(max(channels[idx].Opacity, 0), 1),
+		}
+		if channel.Ink != nil {
+			if channel.Ink.Opacity != nil {
+				plate.inkOpacity = inkOpacity(*channel.Ink.Opacity)
+			}
+			plate.sequence = channel.Ink.Sequence
+		}
+		sequenced = sequenced && plate.sequence != nil
+		plates = append(plates, plate)
+	}
+
+	for _, channel := range channels {
+		if !slices.ContainsFunc(page.ChannelsV4, func(c *ChannelV4) bool { return c.Name == channel.Name }) {
+			return nil, fmt.Errorf("page %d: channel %s not found", pageNum, channel.Name)
+		}
+	}
+
+	if sequenced {
+		slices.SortStableFunc(plates, func(a, b *mixPlate) int {
+			return *a.sequence - *b.sequence
+		})
+	}
+	return plates, nil
+}
+
+// inkOpacity normalize Esko ink opacity to 0..1, opacity may be set in percents
+func inkOpacity(v float64) float64 {
+	if v > 1 {
+		v /= 100
+	}
+	return min(max(v, 0), 1)
+}
+
+// archive open DZI zip and index its tiles, archives are cached by name
+func (m *InkMixer) archive(name string) (*mixArchive, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if archive, ok := m.archives[name]; ok {
+		return archive, nil
+	}
+
+	r, size, err := m.source.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	archive := &mixArchive{tiles: make(map[string]*zip.File)}
+	if closer, ok := r.(io.Closer); ok {
+		archive.closer = closer
+	}
+
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		if archive.closer != nil {
+			archive.closer.Close()
+		}
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	for _, file := range reader.File {
+		if strings.HasSuffix(file.Name, ".dzi") {
+			if archive.descriptor, err = readZipEntry(file); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if strings.HasSuffix(file.Name, ".xml") || strings.HasSuffix(file.Name, "/") {
+			continue
+		}
+		parts := strings.Split(file.Name, "/")
+		if len(parts) < 2 {
+			continue
+		}
+		archive.tiles[path.Join(parts[len(parts)-2], parts[len(parts)-1])] = file
+	}
+	if archive.descriptor == nil {
+		if archive.closer != nil {
+			archive.closer.Close()
+		}
+		return nil, fmt.Errorf("%s: no DZI descriptor", name)
+	}
+
+	m.archives[name] = archive
+	return archive, nil
+}
+
+func (m *InkMixer) tileName(level, col, row int) string {
+	return fmt.Sprintf("%d/%d_%d.%s", level, col, row, m.tileFormat())
+}
+
+func (m *InkMixer) tileFormat() string {
+	if m.manifest.TileFormat == "" {
+		return "png"
+	}
+	return m.manifest.TileFormat
+}
+
+// mixTile composite tile of plates. Each plate with ink coverage k = opacity * (1 - gray / 255)
+// and ink opacity o blends over result as out * (1 - k + k * (1 - o) * color) + k * o * color,
+// which is plain multiply for transparent inks.
+func (m *InkMixer) mixTile(plates []*mixPlate, name string) (*vips.ImageRef, error) {
+	var out *vips.ImageRef
+	for _, plate := range plates {
+		file, ok := plate.archive.tiles[name]
+		if !ok {
+			if out != nil {
+				out.Close()
+			}
+			return nil, fmt.Errorf("tile %s not found", name)
+		}
+
+		factor, offset, err := plateTile(file, plate)
+		if err != nil {
+			if out != nil {
+				out.Close()
+			}
+			return nil, err
+		}
+
+		if out == nil {
+			out = factor
+		} else {
+			err = out.Multiply(factor)
+			factor.Close()
+			if err != nil {
+				out.Close()
+				if offset != nil {
+					offset.Close()
+				}
+				return nil, err
+			}
+		}
+		if offset != nil {
+			err = out.Add(offset)
+			offset.Close()
+			if err != nil {
+				out.Close()
+				return nil, err
+			}
+		}
+	}
+
+	if err := out.Linear([]float64{255}, []float64{0}); err != nil {
+		out.Close()
+		return nil, err
+	}
+	if err := out.Cast(vips.BandFormatUchar); err != nil {
+		out.Close()
+		return nil, err
+	}
+	// Plates are B-W images, the result keeps their interpretation and must be marked as RGB for savers
+	rgb, err := out.CopyChangingInterpretation(vips.InterpretationSRGB)
+	out.Close()
+	if err != nil {
+		return nil, err
+	}
+	return rgb, nil
+}
+
+// plateTile load separation tile and return its multiply factor and, for opaque inks, the additive offset
+func plateTile(file *zip.File, plate *mixPlate) (*vips.ImageRef, *vips.ImageRef, error) {
+	buffer, err := readZipEntry(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	gray, err := vips.NewImageFromBuffer(buffer)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer gray.Close()
+	if gray.Bands() > 1 {
+		if err = gray.ExtractBand(0, 1); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	color := []float64{plate.color.R, plate.color.G, plate.color.B}
+	alpha, o := plate.opacity, plate.inkOpacity
+
+	a := make([]float64, 3)
+	b := make([]float64, 3)
+	for i, c := range color {
+		d := 1 - (1-o)*c
+		a[i] = alpha * d / 255
+		b[i] = 1 - alpha*d
+	}
+	factor, err := gray.Copy()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = factor.Linear(a, b); err != nil {
+		factor.Close()
+		return nil, nil, err
+	}
+	if o == 0 {
+		return factor, nil, nil
+	}
+
+	for i, c := range color {
+		a[i] = -alpha * o * c / 255
+		b[i] = alpha * o * c
+	}
+	offset, err := gray.Copy()
+	if err != nil {
+		factor.Close()
+		return nil, nil, err
+	}
+	if err = offset.Linear(a, b); err != nil {
+		factor.Close()
+		offset.Close()
+		return nil, nil, err
+	}
+	return factor, offset, nil
+}
+
+func (m *InkMixer) encodeTile(ref *vips.ImageRef) ([]byte, error) {
+	return encodeTile(ref, m.tileFormat(), tileOptions{})
+}
+
+func readZipEntry(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// storeZipEntry write file to zip without compression, so tiles can be read by byte ranges
+func storeZipEntry(writer *zip.Writer, name string, buffer []byte) error {
+	w, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buffer)
+	return err
+}
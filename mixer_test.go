@@ -0,0 +1,164 @@
+package dzi
+
+import (
+	"archive/zip"
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+var vipsOnce sync.Once
+
+func startVips() {
+	vipsOnce.Do(func() {
+		vips.LoggingSettings(nil, vips.LogLevelError)
+		vips.Startup(nil)
+	})
+}
+
+// memSource is MixSource over DZI zips in memory
+type memSource map[string][]byte
+
+func (m memSource) Open(name string) (io.ReaderAt, int64, error) {
+	buffer, ok := m[name]
+	if !ok {
+		return nil, 0, os.ErrNotExist
+	}
+	return bytes.NewReader(buffer), int64(len(buffer)), nil
+}
+
+// bwDZI return DZI zip with a single 1 row tile of gray separation values, 0 is full ink
+func bwDZI(t *testing.T, name string, values []uint8) []byte {
+	t.Helper()
+	gray := image.NewGray(image.Rect(0, 0, len(values), 1))
+	copy(gray.Pix, values)
+	var tile bytes.Buffer
+	if err := png.Encode(&tile, gray); err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	if err := storeZipEntry(writer, name+"/"+name+".dzi", []byte("<Image/>")); err != nil {
+		t.Fatal(err)
+	}
+	if err := storeZipEntry(writer, name+"/"+name+"_files/0/0_0.png", tile.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func TestInkMixerTile(t *testing.T) {
+	startVips()
+
+	source := memSource{
+		"/1/dzi_bw/page_1/Cyan.zip":    bwDZI(t, "Cyan", []uint8{0, 255, 0, 128}),
+		"/1/dzi_bw/page_1/Magenta.zip": bwDZI(t, "Magenta", []uint8{0, 0, 255, 255}),
+	}
+	opaque, first, second := 100.0, 1, 2
+	manifest := func(magentaInk *InkAttributes, cyanInk *InkAttributes) *Manifest {
+		return &Manifest{
+			TileFormat: "png",
+			Swatches: []*Swatch{
+				{Name: "Cyan", RBG: "#00ffff"},
+				{Name: "Magenta", RBG: "#ff00ff"},
+			},
+			Pages: []*Page{{
+				PageNum: 1,
+				Mode:    string(ColorModeCMYK),
+				ChannelsV4: []*ChannelV4{
+					{Name: "Cyan", DziBWPath: "/1/dzi_bw/page_1/Cyan.zip", Ink: cyanInk},
+					{Name: "Magenta", DziBWPath: "/1/dzi_bw/page_1/Magenta.zip", Ink: magentaInk},
+				},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		manifest *Manifest
+		channels []MixChannel
+		pixels   []color.NRGBA
+	}{
+		{
+			name:     "multiply",
+			manifest: manifest(nil, nil),
+			channels: FullInks("Cyan", "Magenta"),
+			pixels: []color.NRGBA{
+				{0, 0, 255, 255}, {255, 0, 255, 255}, {0, 255, 255, 255}, {127, 255, 255, 255},
+			},
+		},
+		{
+			name:     "opacity",
+			manifest: manifest(nil, nil),
+			channels: []MixChannel{{Name: "Cyan", Opacity: 0.5}},
+			pixels: []color.NRGBA{
+				{127, 255, 255, 255}, {255, 255, 255, 255}, {127, 255, 255, 255}, {191, 255, 255, 255},
+			},
+		},
+		{
+			// Opaque magenta printed last covers cyan
+			name: "opaque ink",
+			manifest: manifest(
+				&InkAttributes{Opacity: &opaque, Sequence: &second},
+				&InkAttributes{Sequence: &first},
+			),
+			channels: FullInks("Cyan", "Magenta"),
+			pixels: []color.NRGBA{
+				{255, 0, 255, 255}, {255, 0, 255, 255}, {0, 255, 255, 255}, {127, 255, 255, 255},
+			},
+		},
+		{
+			// Cyan printed over opaque magenta is multiplied
+			name: "ink sequence",
+			manifest: manifest(
+				&InkAttributes{Opacity: &opaque, Sequence: &first},
+				&InkAttributes{Sequence: &second},
+			),
+			channels: FullInks("Cyan", "Magenta"),
+			pixels: []color.NRGBA{
+				{0, 0, 255, 255}, {255, 0, 255, 255}, {0, 255, 255, 255}, {127, 255, 255, 255},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mixer := NewInkMixer(tt.manifest, source)
+			defer mixer.Close()
+
+			buffer, err := mixer.Tile(1, tt.channels, 0, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			img, err := png.Decode(bytes.NewReader(buffer))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if width := img.Bounds().Dx(); width != len(tt.pixels) {
+				t.Fatalf("tile width %d, want %d", width, len(tt.pixels))
+			}
+			for x, want := range tt.pixels {
+				got := color.NRGBAModel.Convert(img.At(x, 0)).(color.NRGBA)
+				if !closeChannel(got.R, want.R) || !closeChannel(got.G, want.G) || !closeChannel(got.B, want.B) {
+					t.Errorf("pixel %d = %v, want %v", x, got, want)
+				}
+			}
+		})
+	}
+}
+
+// closeChannel compare 8 bit values with rounding tolerance
+func closeChannel(a, b uint8) bool {
+	return max(a, b)-min(a, b) <= 1
+}
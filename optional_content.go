@@ -0,0 +1,145 @@
+package dzi
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LayerCombination describes a configured on/off state of PDF optional content groups.
+// Layers not listed in On or Off keep their default visibility.
+type LayerCombination struct {
+	Name string
+	On   []string
+	Off  []string
+}
+
+type pdfLayer struct {
+	Index   int
+	Name    string
+	Visible bool
+}
+
+// getPagesLayers collect optional content groups used on each page over mutool and inspect.js script file
+func getPagesLayers(fileName string) (map[int][]*pdfLayer, error) {
+	buff, err := execCmd("mutool", "run", "inspect.js", "layers", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	layers := make(map[int][]*pdfLayer)
+	for _, line := range strings.Split(string(buff), "\n") {
+		if !strings.HasPrefix(line, "Page ") {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(line, "Page "), "\t", 4)
+		if len(parts) < 4 {
+			continue
+		}
+		pageNum, err1 := strconv.Atoi(parts[0])
+		index, err2 := strconv.Atoi(parts[1])
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		layers[pageNum] = append(layers[pageNum], &pdfLayer{
+			Index:   index,
+			Name:    strings.TrimSpace(parts[3]),
+			Visible: parts[2] == "on",
+		})
+	}
+	return layers, nil
+}
+
+// renderLayers draw page with only visible layers switched on. mutool writes a copy of the document
+// with the layer state as default configuration, the copy is rendered by Ghostscript with the device
+// and overprint of the color composite, so layer channels get the same color path as Color.
+func renderLayers(fileName, output string, page *pageSize, visible []int, c *Config) error {
+	visibleArg := "-"
+	if len(visible) > 0 {
+		indexes := make([]string, len(visible))
+		for i, v := range visible {
+			indexes[i] = strconv.Itoa(v)
+		}
+		visibleArg = strings.Join(indexes, ",")
+	}
+
+	layeredPath := fmt.Sprintf("%s.pdf", strings.TrimSuffix(output, path.Ext(output)))
+	if _, err := execCmd("mutool", "run", "inspect.js", "layered", fileName, visibleArg, layeredPath); err != nil {
+		return err
+	}
+	defer os.Remove(layeredPath)
+
+	device := compositeDevice(c)
+	_, err := runGS(layeredPath, output, page, device, compositeOverprint(device, c.Overprint), c)
+	return err
+}
+
+// renderLayerVariants renders each page layer on its own and each configured layer combination,
+// and registers results as color channels of the page
+func renderLayerVariants(fileName, outputFolder, basename string, page *pageSize, spots channelsMap, c *Config) error {
+	if len(page.Layers) == 0 {
+		return nil
+	}
+
+	ext := "tiff"
+	if compositeDevice(c) == "png16m" {
+		ext = "png"
+	}
+
+	render := func(name, opsName string, visible []int) error {
+		outputFilepath := fmt.Sprintf("%s/%s(%s).%s", outputFolder, basename, opsName, ext)
+		log.Printf("[>] Render %s for page #%d", name, page.PageNum)
+		if err := renderLayers(fileName, outputFilepath, page, visible, c); err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(visible))
+		for _, layer := range page.Layers {
+			if slices.Contains(visible, layer.Index) {
+				names = append(names, layer.Name)
+			}
+		}
+
+		spots[name] = &channelFile{
+			Filepath: outputFilepath,
+			OpsName:  opsName,
+			IsColor:  true,
+			Variant:  VariantLayers,
+			Layers:   names,
+		}
+		return nil
+	}
+
+	if c.RenderLayers {
+		for _, layer := range page.Layers {
+			if err := render(fmt.Sprintf("Layer %s", layer.Name), fmt.Sprintf("Layer_%d", layer.Index), []int{layer.Index}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for idx, combination := range c.LayerCombinations {
+		visible := make([]int, 0)
+		for _, layer := range page.Layers {
+			on := layer.Visible
+			if slices.Contains(combination.On, layer.Name) {
+				on = true
+			}
+			if slices.Contains(combination.Off, layer.Name) {
+				on = false
+			}
+			if on {
+				visible = append(visible, layer.Index)
+			}
+		}
+		if err := render(fmt.Sprintf("Layers %s", combination.Name), fmt.Sprintf("Layers_%d", idx), visible); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
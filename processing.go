@@ -3,7 +3,6 @@ package dzi
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"log"
 	"os"
 	"path"
@@ -12,7 +11,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/alitto/pond"
 	"github.com/davidbyttow/govips/v2/vips"
 	"github.com/google/uuid"
 )
@@ -43,15 +41,40 @@ type Config struct {
 	DebugMode          bool
 	CopyChannelsToS3   bool
 	Overprint          string
+	OverprintModes     []string
 	DefaultDPI         float64
 	MaxSizePixels      float64
 	MaxCpuCount        int
+	VipsConcurrency    int
 	ExtractText        bool
 	TileFormat         string
 	TileSetting        string
 	GraphicsAlphaBits  int
 	UsePDFX3           bool
 	LibreOfficePath    string
+	InkRulesFilepath   string
+	ColorBooksPath     string
+	NameAliasesPath    string
+	CharsetHints       []string
+	SwatchLibraries    []string
+	ExcludeInkRoles    []InkRole
+	SubstrateColor     string
+	SubstrateTexture   string
+	InkCoverage        bool
+	TotalAreaCoverage  bool
+	InkLimit           float64
+	RenderLayers       bool
+	LayerCombinations  []LayerCombination
+	AlphaChannels      bool
+	ProofProfilesPath  string
+	ProofConditions    []string
+	CVDSimulations     []string
+	Screening          bool
+	ScreenRuling       float64
+	ScreenDotShape     string
+	ScreenSettings     []ScreenSetting
+	IIIF               bool
+	IIIFBaseURL        string
 	//SendToAnalyzer     bool
 }
 
@@ -94,10 +117,12 @@ func Processing(url string, assetId int, c *Config) (*Manifest, error) {
 	dziBw := path.Join(tmp, "dzi_bw")
 	channels := path.Join(tmp, "channels")
 	channelsBw := path.Join(tmp, "channels_bw")
+	channelsAlpha := path.Join(tmp, "channels_alpha")
+	dziAlpha := path.Join(tmp, "dzi_alpha")
 	covers := path.Join(tmp, "covers")
 	rangesPath := path.Join(tmp, "ranges")
 
-	if err := prepareTopFolders(tmp, leads, dzi, dziBw, channels, channelsBw, covers, rangesPath); err != nil {
+	if err := prepareTopFolders(tmp, leads, dzi, dziBw, channels, channelsBw, channelsAlpha, dziAlpha, covers, rangesPath); err != nil {
 		return nil, err
 	}
 
@@ -107,6 +132,7 @@ func Processing(url string, assetId int, c *Config) (*Manifest, error) {
 	ext = strings.TrimPrefix(ext, ".")
 
 	log.Println("MaxCpuCount:", c.MaxCpuCount)
+	log.Println("VipsConcurrency:", VipsConcurrency(c))
 	log.Println("Max Resolution:", c.Resolution)
 	log.Println("URL:", url)
 	log.Println("AssetId:", assetId)
@@ -146,37 +172,91 @@ func Processing(url string, assetId int, c *Config) (*Manifest, error) {
 
 	Loader := probe.OriginalFormat()
 
+	inkRules, err := loadInkRules(c.InkRulesFilepath)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, err := newColorResolver(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, library := range c.SwatchLibraries {
+		if err = resolver.books.Import(library); err != nil {
+			return nil, err
+		}
+	}
+
 	var pages []*pageInfo
 	if Loader == vips.ImageTypePDF {
 		log.Println("Processing as PDF file")
-		pages, err = extractPDF(originalFilepath, basename, channels, c)
+		if c.UsePDFX3 {
+			if err = resolver.useOutputIntent(originalFilepath, path.Join(tmp, "output_intent.icc")); err != nil {
+				return nil, err
+			}
+		}
+		pages, err = extractPDF(originalFilepath, basename, channels, inkRules, resolver, c)
 		if err != nil {
 			return nil, err
 		}
 	} else {
 		log.Println("Processing as Image file")
-		pages, err = extractImage(originalFilepath, basename, channels, c)
+		pages, err = extractImage(originalFilepath, basename, channels, inkRules, resolver, c)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if err = colorize(pages, channels, channelsBw, leads, covers, c); err != nil {
+	if err = colorize(pages, channels, channelsBw, channelsAlpha, leads, covers, c); err != nil {
 		return nil, err
 	}
 
-	dziSt := time.Now()
-	panicHandler := func(p interface{}) {
-		fmt.Printf("[!] Task panicked: %v", p)
+	if c.InkCoverage {
+		if err = makeInkCoverage(pages, channelsBw); err != nil {
+			return nil, err
+		}
 	}
 
-	pool := pond.New(c.MaxCpuCount, 1000, pond.MinWorkers(c.MaxCpuCount), pond.PanicHandler(panicHandler))
-	if err = makeDZI(pool, false, pages, channels, dzi, c); err != nil {
+	if c.TotalAreaCoverage {
+		if err = makeTAC(pages, channelsBw, c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Screening {
+		if err = makeScreenedProof(pages, channelsBw, c); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.CVDSimulations) > 0 {
+		if err = makeCVD(pages, c); err != nil {
+			return nil, err
+		}
+	}
+
+	dziSt := time.Now()
+	var iiif, iiifBw *iiifTarget
+	if c.IIIF {
+		if iiif, err = newIIIFTarget(tmp, "iiif", assetId, c); err != nil {
+			return nil, err
+		}
+		if iiifBw, err = newIIIFTarget(tmp, "iiif_bw", assetId, c); err != nil {
+			return nil, err
+		}
+	}
+	pool := newDZIPool(c)
+	if err = makeDZI(pool, false, pages, dzi, iiif, c); err != nil {
 		return nil, err
 	}
-	if err = makeDZI(pool, true, pages, channelsBw, dziBw, c); err != nil {
+	if err = makeDZI(pool, true, pages, dziBw, iiifBw, c); err != nil {
 		return nil, err
 	}
+	if c.AlphaChannels {
+		if err = makeAlphaDZI(pool, pages, dziAlpha, c); err != nil {
+			return nil, err
+		}
+	}
 
 	pool.StopAndWait()
 	if pool.FailedTasks() > 0 {
@@ -198,13 +278,21 @@ func Processing(url string, assetId int, c *Config) (*Manifest, error) {
 		if err = os.RemoveAll(channelsBw); err != nil {
 			return nil, err
 		}
+		log.Println("[-] Remove alpha channels folder")
+		if err = os.RemoveAll(channelsAlpha); err != nil {
+			return nil, err
+		}
 	}
 
-	manifest, err := makeManifest(pages, assetId, c, url, basename, filename, _tmp, rangesPath, st)
+	manifest, err := makeManifest(pages, assetId, c, url, basename, filename, _tmp, rangesPath, resolver.outputIntent, st)
 	if err != nil {
 		return nil, err
 	}
 
+	if manifest.SwatchFiles, err = exportSwatches(manifest.Swatches, tmp, strings.TrimSuffix(_tmp, "/"), basename); err != nil {
+		return nil, err
+	}
+
 	buff, err := json.Marshal(manifest)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,230 @@
+package dzi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	dzi "github.com/brandquad/dzi/colorutils"
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// proofProfile is a press condition for soft proofing
+type proofProfile struct {
+	Name     string
+	Filepath string
+}
+
+// proofProfileExts supported ICC profile files
+var proofProfileExts = []string{".icc", ".icm"}
+
+// proofProfiles return press condition profiles from ProofProfilesPath folder. Conditions are matched
+// to profile file names without extension: exact case-insensitive name first, then unique prefix.
+// All profiles of the folder are used when no conditions are set.
+func proofProfiles(c *Config) ([]*proofProfile, error) {
+	if c.ProofProfilesPath == "" {
+		return nil, nil
+	}
+
+	files, err := os.ReadDir(c.ProofProfilesPath)
+	if err != nil {
+		return nil, err
+	}
+	available := make([]*proofProfile, 0)
+	for _, file := range files {
+		ext := filepath.Ext(file.Name())
+		if file.IsDir() || !slices.Contains(proofProfileExts, strings.ToLower(ext)) {
+			continue
+		}
+		available = append(available, &proofProfile{
+			Name:     strings.TrimSuffix(file.Name(), ext),
+			Filepath: path.Join(c.ProofProfilesPath, file.Name()),
+		})
+	}
+	if len(c.ProofConditions) == 0 {
+		return available, nil
+	}
+
+	profiles := make([]*proofProfile, 0, len(c.ProofConditions))
+	for _, condition := range c.ProofConditions {
+		condition = strings.TrimSpace(condition)
+		if condition == "" {
+			continue
+		}
+		idx := slices.IndexFunc(available, func(p *proofProfile) bool {
+			return strings.EqualFold(p.Name, condition)
+		})
+		if idx == -1 {
+			var matches []int
+			for i, p := range available {
+				if strings.HasPrefix(strings.ToLower(p.Name), strings.ToLower(condition)) {
+					matches = append(matches, i)
+				}
+			}
+			if len(matches) != 1 {
+				return nil, fmt.Errorf("proof condition %q: %d matching profiles in %s", condition, len(matches), c.ProofProfilesPath)
+			}
+			idx = matches[0]
+		}
+		profiles = append(profiles, &proofProfile{Name: condition, Filepath: available[idx].Filepath})
+	}
+	return profiles, nil
+}
+
+// renderProofVariants transform CMYK composite through each press condition profile and register results
+// as color channels of the page. libvips transforms in-process with perceptual intent only, so absolute
+// colorimetric intent is simulated: result is multiplied by the media white of the profile in linear light.
+func renderProofVariants(outputFolder, basename, compositePath string, profiles []*proofProfile, spots channelsMap, c *Config) error {
+	for _, profile := range profiles {
+		opsName := fmt.Sprintf("Proof_%s", profile.Name)
+		outputFilepath := fmt.Sprintf("%s/%s(%s).png", outputFolder, basename, opsName)
+		if c.DebugMode {
+			log.Printf("[D] Proof %s with input profile %s", compositePath, profile.Filepath)
+		}
+		if err := renderProof(compositePath, outputFilepath, profile.Filepath, c); err != nil {
+			return fmt.Errorf("proof %s: %w", profile.Name, err)
+		}
+
+		spots[fmt.Sprintf("Proof %s", profile.Name)] = &channelFile{
+			Filepath: outputFilepath,
+			OpsName:  opsName,
+			IsColor:  true,
+			Variant:  VariantProof,
+			Profile:  filepath.Base(profile.Filepath),
+		}
+	}
+	return nil
+}
+
+// renderProof convert CMYK composite to sRGB with the press condition profile as input profile
+// and multiply it by the paper white of the condition in linear light
+func renderProof(compositePath, outputFilepath, profilePath string, c *Config) error {
+	paper, err := paperWhite(profilePath)
+	if err != nil {
+		return err
+	}
+
+	ref, err := vips.LoadImageFromFile(compositePath, nil)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	// Embedded profile would take precedence over the condition profile
+	if err = ref.RemoveICCProfile(); err != nil {
+		return err
+	}
+	if err = ref.TransformICCProfileWithFallback(c.ICCProfileFilepath, profilePath); err != nil {
+		return err
+	}
+	if paper[0] < 1 || paper[1] < 1 || paper[2] < 1 {
+		if err = ref.ToColorSpace(vips.InterpretationScRGB); err != nil {
+			return err
+		}
+		if err = ref.Linear(paper, []float64{0, 0, 0}); err != nil {
+			return err
+		}
+		if err = ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
+			return err
+		}
+	}
+	return toPng(ref, outputFilepath)
+}
+
+// iccD50 is PCS illuminant of ICC profiles
+var iccD50 = []float64{0.9642, 1, 0.8249}
+
+// paperWhite return linear sRGB multipliers of the media white of output ICC profile, 1, 1, 1 when the
+// profile has no media white. White point of display profiles is the illuminant, not paper, so it is ignored.
+// ICC v4 profiles may keep D50 in wtpt tag with the adaptation in chad tag, media white is restored by
+// inverse chad then.
+func paperWhite(profilePath string) ([]float64, error) {
+	noPaper := []float64{1, 1, 1}
+
+	buffer, err := os.ReadFile(profilePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(buffer) < 132 || string(buffer[36:40]) != "acsp" {
+		return nil, fmt.Errorf("%s is not ICC profile", profilePath)
+	}
+	if string(buffer[12:16]) != "prtr" {
+		return noPaper, nil
+	}
+
+	tags := iccTags(buffer)
+	white := iccNumbers(tags["wtpt"], "XYZ ", 3)
+	if white == nil {
+		return noPaper, nil
+	}
+	if major := buffer[8]; major >= 4 && slices.EqualFunc(white, iccD50, func(a, b float64) bool { return math.Abs(a-b) < 0.002 }) {
+		if chad := iccNumbers(tags["chad"], "sf32", 9); chad != nil {
+			if white = solve3(chad, white); white == nil {
+				return nil, fmt.Errorf("%s: chad matrix is singular", profilePath)
+			}
+		}
+	}
+
+	paper := dzi.Xyz2linearRgb(white)
+	for i, v := range paper {
+		paper[i] = min(max(v, 0), 1)
+	}
+	return paper, nil
+}
+
+// iccTags return data of ICC profile tags by signature
+func iccTags(buffer []byte) map[string][]byte {
+	tags := make(map[string][]byte)
+	count := int(binary.BigEndian.Uint32(buffer[128:132]))
+	for idx := 0; idx < count; idx++ {
+		entry := 132 + idx*12
+		if entry+12 > len(buffer) {
+			break
+		}
+		offset := int(binary.BigEndian.Uint32(buffer[entry+4 : entry+8]))
+		size := int(binary.BigEndian.Uint32(buffer[entry+8 : entry+12]))
+		if offset+size > len(buffer) {
+			continue
+		}
+		tags[string(buffer[entry:entry+4])] = buffer[offset : offset+size]
+	}
+	return tags
+}
+
+// iccNumbers return s15Fixed16Number values of XYZType or s15Fixed16ArrayType tag data,
+// values follow type signature and reserved bytes
+func iccNumbers(data []byte, typeSignature string, count int) []float64 {
+	if len(data) < 8+count*4 || string(data[:4]) != typeSignature {
+		return nil
+	}
+	values := make([]float64, count)
+	for i := range values {
+		values[i] = float64(int32(binary.BigEndian.Uint32(data[8+i*4:12+i*4]))) / 65536
+	}
+	return values
+}
+
+// solve3 return x of m * x = v for 3x3 row-major matrix m, nil when m is singular
+func solve3(m, v []float64) []float64 {
+	det := func(a, b, c []float64) float64 {
+		return a[0]*(b[1]*c[2]-b[2]*c[1]) - b[0]*(a[1]*c[2]-a[2]*c[1]) + c[0]*(a[1]*b[2]-a[2]*b[1])
+	}
+	// Columns of the matrix
+	cols := [][]float64{{m[0], m[3], m[6]}, {m[1], m[4], m[7]}, {m[2], m[5], m[8]}}
+	d := det(cols[0], cols[1], cols[2])
+	if math.Abs(d) < 1e-12 {
+		return nil
+	}
+	// Cramer's rule
+	return []float64{
+		det(v, cols[1], cols[2]) / d,
+		det(cols[0], v, cols[2]) / d,
+		det(cols[0], cols[1], v) / d,
+	}
+}
@@ -0,0 +1,94 @@
+package dzi
+
+import (
+	"encoding/binary"
+	"math"
+	"os"
+	"path"
+	"testing"
+
+	dzi "github.com/brandquad/dzi/colorutils"
+)
+
+// iccProfile return minimal ICC profile with header and tags of s15Fixed16Number values
+func iccProfile(major byte, class string, tags map[string][]float64) []byte {
+	header := make([]byte, 128)
+	header[8] = major
+	copy(header[12:16], class)
+	copy(header[36:40], "acsp")
+
+	table := binary.BigEndian.AppendUint32(nil, uint32(len(tags)))
+	var data []byte
+	offset := 128 + 4 + len(tags)*12
+	for _, signature := range []string{"wtpt", "chad"} {
+		values, ok := tags[signature]
+		if !ok {
+			continue
+		}
+		typeSignature := "XYZ "
+		if signature == "chad" {
+			typeSignature = "sf32"
+		}
+		tag := append([]byte(typeSignature), 0, 0, 0, 0)
+		for _, v := range values {
+			tag = binary.BigEndian.AppendUint32(tag, uint32(int32(math.Round(v*65536))))
+		}
+		table = append(table, signature...)
+		table = binary.BigEndian.AppendUint32(table, uint32(offset+len(data)))
+		table = binary.BigEndian.AppendUint32(table, uint32(len(tag)))
+		data = append(data, tag...)
+	}
+	return append(append(header, table...), data...)
+}
+
+func TestPaperWhite(t *testing.T) {
+	// Yellowish paper, XYZ relative to D50 PCS
+	paper := []float64{0.89, 0.93, 0.70}
+	expected := dzi.Xyz2linearRgb(paper)
+	white := []float64{1, 1, 1}
+	// chad adapting the paper to D50
+	chad := []float64{iccD50[0] / paper[0], 0, 0, 0, iccD50[1] / paper[1], 0, 0, 0, iccD50[2] / paper[2]}
+
+	tests := []struct {
+		name    string
+		profile []byte
+		paper   []float64
+	}{
+		{"v2 printer", iccProfile(2, "prtr", map[string][]float64{"wtpt": paper}), expected},
+		{"v4 printer with D50 wtpt", iccProfile(4, "prtr", map[string][]float64{"wtpt": iccD50, "chad": chad}), expected},
+		{"v4 printer with media wtpt", iccProfile(4, "prtr", map[string][]float64{"wtpt": paper, "chad": chad}), expected},
+		{"v4 printer without chad", iccProfile(4, "prtr", map[string][]float64{"wtpt": iccD50}), white},
+		{"v2 display", iccProfile(2, "mntr", map[string][]float64{"wtpt": []float64{0.9505, 1, 1.089}}), white},
+		{"no wtpt", iccProfile(2, "prtr", nil), white},
+	}
+	folder := t.TempDir()
+	for _, tt := range tests {
+		profilePath := path.Join(folder, tt.name+".icc")
+		if err := os.WriteFile(profilePath, tt.profile, 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := paperWhite(profilePath)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		for i := range got {
+			if math.Abs(got[i]-tt.paper[i]) > 1e-3 {
+				t.Errorf("%s: paper %v, want %v", tt.name, got, tt.paper)
+				break
+			}
+		}
+	}
+
+	if expected[2] >= expected[0] {
+		t.Errorf("yellowish paper %v has no less blue than red", expected)
+	}
+
+	profilePath := path.Join(folder, "broken.icc")
+	if err := os.WriteFile(profilePath, []byte("not a profile"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := paperWhite(profilePath); err == nil {
+		t.Error("broken profile: no error")
+	}
+}
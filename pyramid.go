@@ -0,0 +1,235 @@
+package dzi
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+// dziDescriptor is the .dzi file of the pyramid as written by vips dzsave
+const dziDescriptor = `<?xml version="1.0" encoding="UTF-8"?>
+<Image xmlns="http://schemas.microsoft.com/deepzoom/2008"
+  Format="%s"
+  Overlap="%d"
+  TileSize="%d"
+  >
+  <Size
+    Height="%d"
+    Width="%d"
+  />
+</Image>
+`
+
+// tileOptions are encoding options of tiles from DZI_TILE_SETTING in vips suffix form, like "[Q=90,lossless]"
+type tileOptions struct {
+	Quality     int
+	Compression int
+	Lossless    bool
+}
+
+// parseTileSetting parse vips save options, unknown options are ignored
+func parseTileSetting(setting string) (tileOptions, error) {
+	var opts tileOptions
+	setting = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(setting), "["), "]")
+	for _, item := range strings.Split(setting, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(item), "=")
+		var err error
+		switch strings.ToLower(key) {
+		case "q":
+			opts.Quality, err = strconv.Atoi(value)
+		case "compression":
+			opts.Compression, err = strconv.Atoi(value)
+		case "lossless":
+			opts.Lossless = value == "" || value == "true" || value == "1"
+		}
+		if err != nil {
+			return opts, fmt.Errorf("tile setting %q: %w", item, err)
+		}
+	}
+	return opts, nil
+}
+
+// encodeTile export tile in the tile format, options override defaults of the format
+func encodeTile(ref *vips.ImageRef, format string, opts tileOptions) ([]byte, error) {
+	var buffer []byte
+	var err error
+	switch format {
+	case "jpeg", "jpg":
+		params := vips.NewJpegExportParams()
+		params.StripMetadata = true
+		if opts.Quality > 0 {
+			params.Quality = opts.Quality
+		}
+		buffer, _, err = ref.ExportJpeg(params)
+	case "webp":
+		params := vips.NewWebpExportParams()
+		params.StripMetadata = true
+		params.Lossless = opts.Lossless
+		if opts.Quality > 0 {
+			params.Quality = opts.Quality
+		}
+		buffer, _, err = ref.ExportWebp(params)
+	default:
+		params := vips.NewPngExportParams()
+		params.StripMetadata = true
+		if opts.Compression > 0 {
+			params.Compression = opts.Compression
+		}
+		buffer, _, err = ref.ExportPng(params)
+	}
+	return buffer, err
+}
+
+// dziLevelSize return size of the pyramid level, levels halve the image down to one pixel at level 0
+func dziLevelSize(width, height, maxLevel, level int) (int, int) {
+	shift := maxLevel - level
+	return (width + 1<<shift - 1) >> shift, (height + 1<<shift - 1) >> shift
+}
+
+// dziMaxLevel return the level of the full size image
+func dziMaxLevel(width, height int) int {
+	var level int
+	for size := max(width, height); size > 1; size = (size + 1) / 2 {
+		level++
+	}
+	return level
+}
+
+// saveDZI write pyramid of the image into zip in layout of vips dzsave zip container:
+// name/name_files/<level>/<col>_<row>.<format> and name/name.dzi. Entries are stored without compression,
+// so tiles can be read by byte ranges. As in dzsave, each level is built from the previous one by halving.
+// Tiles are encoded in-process one by one, pyramids of different channels are built in parallel by the caller pool.
+func saveDZI(ref *vips.ImageRef, dziPath, format, setting string, c *Config) error {
+	tileSize, err := strconv.Atoi(c.TileSize)
+	if err != nil || tileSize <= 0 {
+		return fmt.Errorf("tile size %q is not correct", c.TileSize)
+	}
+	overlap, err := strconv.Atoi(c.Overlap)
+	if err != nil || overlap < 0 {
+		return fmt.Errorf("overlap %q is not correct", c.Overlap)
+	}
+	opts, err := parseTileSetting(setting)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(dziPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	basename := strings.TrimSuffix(filepath.Base(dziPath), filepath.Ext(dziPath))
+	writer := zip.NewWriter(file)
+
+	width, height := ref.Width(), ref.Height()
+	maxLevel := dziMaxLevel(width, height)
+	levelRef, err := ref.Copy()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		levelRef.Close()
+	}()
+	for level := maxLevel; level >= 0; level-- {
+		if level < maxLevel {
+			next, err := halveLevel(levelRef)
+			if err != nil {
+				return fmt.Errorf("dzi level %d of %s: %w", level, dziPath, err)
+			}
+			levelRef.Close()
+			levelRef = next
+			if w, h := dziLevelSize(width, height, maxLevel, level); levelRef.Width() != w || levelRef.Height() != h {
+				return fmt.Errorf("dzi level %d of %s is %dx%d, expected %dx%d", level, dziPath, levelRef.Width(), levelRef.Height(), w, h)
+			}
+		}
+		if err = saveDZILevel(writer, levelRef, basename, format, opts, level, tileSize, overlap); err != nil {
+			return fmt.Errorf("dzi level %d of %s: %w", level, dziPath, err)
+		}
+	}
+
+	// Descriptor goes last as in dzsave, covers expect tile folders first
+	descriptor := fmt.Sprintf(dziDescriptor, format, overlap, tileSize, height, width)
+	if err = storeZipEntry(writer, path.Join(basename, basename+".dzi"), []byte(descriptor)); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// dziMaterializePixels levels up to this number of pixels are kept in memory, so smaller levels don't
+// recompute the pyramid from the full size image. Larger levels are shrunk lazily from the previous one.
+const dziMaterializePixels = 1 << 20
+
+// halveLevel return the next pyramid level: odd sides are extended by copying the edge, then image is
+// shrunk twice to ceil(w/2) x ceil(h/2). Only small levels are materialized, see dziMaterializePixels.
+func halveLevel(ref *vips.ImageRef) (*vips.ImageRef, error) {
+	width, height := ref.Width(), ref.Height()
+	levelRef, err := ref.Copy()
+	if err != nil {
+		return nil, err
+	}
+	defer levelRef.Close()
+
+	if width%2 != 0 || height%2 != 0 {
+		if err = levelRef.Embed(0, 0, width+width%2, height+height%2, vips.ExtendCopy); err != nil {
+			return nil, err
+		}
+	}
+	if err = levelRef.ResizeWithVScale(0.5, 0.5, vips.KernelLinear); err != nil {
+		return nil, err
+	}
+	if levelRef.Width()*levelRef.Height() > dziMaterializePixels {
+		return levelRef.Copy()
+	}
+
+	params := vips.NewTiffExportParams()
+	params.Compression = vips.TiffCompressionNone
+	params.Predictor = vips.TiffPredictorNone
+	buffer, _, err := levelRef.ExportTiff(params)
+	if err != nil {
+		return nil, err
+	}
+	return vips.NewImageFromBuffer(buffer)
+}
+
+func saveDZILevel(writer *zip.Writer, levelRef *vips.ImageRef, basename, format string, opts tileOptions, level, tileSize, overlap int) error {
+	width, height := levelRef.Width(), levelRef.Height()
+	for row := 0; row*tileSize < height; row++ {
+		for col := 0; col*tileSize < width; col++ {
+			// Tiles overlap neighbours on each inner edge
+			left := max(col*tileSize-overlap, 0)
+			top := max(row*tileSize-overlap, 0)
+			right := min((col+1)*tileSize+overlap, width)
+			bottom := min((row+1)*tileSize+overlap, height)
+
+			tile, err := levelRef.Copy()
+			if err != nil {
+				return err
+			}
+			err = tile.ExtractArea(left, top, right-left, bottom-top)
+			var buffer []byte
+			if err == nil {
+				buffer, err = encodeTile(tile, format, opts)
+			}
+			tile.Close()
+			if err != nil {
+				return err
+			}
+
+			name := path.Join(basename, basename+"_files", strconv.Itoa(level), fmt.Sprintf("%d_%d.%s", col, row, format))
+			if err = storeZipEntry(writer, name, buffer); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
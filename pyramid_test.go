@@ -0,0 +1,89 @@
+package dzi
+
+import "testing"
+
+// Expected levels follow the Deep Zoom layout written by vips dzsave: the full size image is level
+// ceil(log2(max(w, h))), each lower level is ceil(w/2) x ceil(h/2) of the previous one down to 1x1.
+func TestDZIMaxLevel(t *testing.T) {
+	tests := []struct {
+		width, height int
+		level         int
+	}{
+		{1, 1, 0},
+		{2, 1, 1},
+		{256, 256, 8},
+		{257, 100, 9},
+		{1000, 800, 10},
+		{1024, 1024, 10},
+		{1025, 10, 11},
+		{4961, 7016, 13},
+	}
+	for _, tt := range tests {
+		if got := dziMaxLevel(tt.width, tt.height); got != tt.level {
+			t.Errorf("dziMaxLevel(%d, %d) = %d, want %d", tt.width, tt.height, got, tt.level)
+		}
+	}
+}
+
+func TestDZILevelSize(t *testing.T) {
+	tests := []struct {
+		width, height int
+		maxLevel      int
+		level         int
+		w, h          int
+	}{
+		{1000, 800, 10, 10, 1000, 800},
+		{1000, 800, 10, 9, 500, 400},
+		{1000, 800, 10, 1, 2, 2},
+		{1000, 800, 10, 0, 1, 1},
+		{1025, 10, 11, 10, 513, 5},
+		{1025, 10, 11, 1, 2, 1},
+		{4961, 7016, 13, 12, 2481, 3508},
+		{4961, 7016, 13, 8, 156, 220},
+		{4961, 7016, 13, 3, 5, 7},
+		{4961, 7016, 13, 0, 1, 1},
+	}
+	for _, tt := range tests {
+		w, h := dziLevelSize(tt.width, tt.height, tt.maxLevel, tt.level)
+		if w != tt.w || h != tt.h {
+			t.Errorf("dziLevelSize(%d, %d, %d, %d) = %dx%d, want %dx%d", tt.width, tt.height, tt.maxLevel, tt.level, w, h, tt.w, tt.h)
+		}
+	}
+
+	// Levels made by halving the previous one match sizes computed from the full size image
+	width, height := 4961, 7016
+	maxLevel := dziMaxLevel(width, height)
+	w, h := width, height
+	for level := maxLevel - 1; level >= 0; level-- {
+		w, h = (w+1)/2, (h+1)/2
+		if lw, lh := dziLevelSize(width, height, maxLevel, level); lw != w || lh != h {
+			t.Errorf("level %d = %dx%d, halved %dx%d", level, lw, lh, w, h)
+		}
+	}
+}
+
+func TestParseTileSetting(t *testing.T) {
+	tests := []struct {
+		setting string
+		opts    tileOptions
+		err     bool
+	}{
+		{setting: "", opts: tileOptions{}},
+		{setting: "[Q=90]", opts: tileOptions{Quality: 90}},
+		{setting: "[Q=90,lossless]", opts: tileOptions{Quality: 90, Lossless: true}},
+		{setting: "[lossless=false]", opts: tileOptions{}},
+		{setting: "[compression=6]", opts: tileOptions{Compression: 6}},
+		{setting: " [Q=85, strip] ", opts: tileOptions{Quality: 85}},
+		{setting: "[Q=x]", err: true},
+	}
+	for _, tt := range tests {
+		opts, err := parseTileSetting(tt.setting)
+		if (err != nil) != tt.err {
+			t.Errorf("parseTileSetting(%q) error = %v, want error %v", tt.setting, err, tt.err)
+			continue
+		}
+		if !tt.err && opts != tt.opts {
+			t.Errorf("parseTileSetting(%q) = %+v, want %+v", tt.setting, opts, tt.opts)
+		}
+	}
+}
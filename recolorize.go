@@ -0,0 +1,282 @@
+package dzi
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// recolorChannel is a separation of the stored asset to colorize again
+type recolorChannel struct {
+	page    *Page
+	channel *ChannelV4
+	swatch  *Swatch
+}
+
+// Recolorize colorize separations of the processed asset with new swatch colors without rendering the source
+// again. Colors map swatch name to preview color like "#e4002b". B-W separations stored in channels_bw are
+// colorized, their color DZI, alpha DZI and covers are rebuilt and manifest.json is updated.
+// Composites and analysis channels are kept as is.
+func Recolorize(assetId int, colors map[string]string, c *Config) (*Manifest, error) {
+	st := time.Now()
+	defer func() {
+		log.Printf("[***] Recolorized in %s", time.Since(st))
+	}()
+
+	if len(colors) == 0 {
+		return nil, errors.New("no swatch colors to recolorize")
+	}
+
+	_tmp, tmp := assetFolders(assetId, c)
+	channels := path.Join(tmp, "channels")
+	channelsBw := path.Join(tmp, "channels_bw")
+	channelsAlpha := path.Join(tmp, "channels_alpha")
+
+	if !c.DebugMode {
+		if err := prepareTopFolders(tmp); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := os.RemoveAll(tmp); err != nil {
+				log.Printf("Error removing directory: %v", tmp)
+			}
+		}()
+	}
+
+	manifest, err := loadManifest(assetId, tmp, c)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := recolorConfig(manifest, c)
+	if err != nil {
+		return nil, err
+	}
+	recolor, err := recolorChannels(manifest, colors)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]*pageInfo, 0)
+	for _, item := range recolor {
+		prefix := path.Base(path.Dir(item.channel.DziColorPath))
+		idx := slices.IndexFunc(pages, func(p *pageInfo) bool {
+			return p.PageNumber == item.page.PageNum
+		})
+		if idx == -1 {
+			pages = append(pages, &pageInfo{
+				Prefix:     prefix,
+				PageNumber: item.page.PageNum,
+				ColorMode:  ColorMode(item.page.Mode),
+			})
+			idx = len(pages) - 1
+			if !c.DebugMode {
+				if err = fetchFromS3(assetId, tmp, c, fmt.Sprintf("channels_bw/%s/", prefix)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		pages[idx].Swatches = append(pages[idx].Swatches, item.swatch)
+	}
+
+	for _, page := range pages {
+		folders, err := prepareFolders(page, channels, channelsBw, channelsAlpha)
+		if err != nil {
+			return nil, err
+		}
+		colorizedFolder, bwFolder := folders[0], folders[1]
+		for _, swatch := range page.Swatches {
+			// processSwatch takes the separation from the channels folder and copies it back to channels_bw
+			swatch.Filepath = path.Join(colorizedFolder, swatch.Basename())
+			bwPath := bwFilepath(swatch, bwFolder)
+			if _, err = os.Stat(bwPath); err != nil {
+				return nil, fmt.Errorf("B-W separation of %s page %d is not stored, asset must be processed with DZI_COPY_CHANNELS=true: %w", swatch.Name, page.PageNumber, err)
+			}
+			if err = cp(bwPath, swatch.Filepath); err != nil {
+				return nil, err
+			}
+
+			var alphaFolder string
+			if swatch.DziAlphaPath != "" {
+				alphaFolder = folders[2]
+			}
+			if err = processSwatch(page, swatch, colorizedFolder, bwFolder, alphaFolder); err != nil {
+				return nil, err
+			}
+		}
+		if err = applySubstrate(page, bwFolder, rc); err != nil {
+			return nil, err
+		}
+	}
+
+	// IIIF images are rebuilt for assets processed with IIIF
+	var iiif *iiifTarget
+	if slices.ContainsFunc(recolor, func(item *recolorChannel) bool { return item.channel.IIIFColorPath != "" }) {
+		if iiif, err = newIIIFTarget(tmp, "iiif", assetId, rc); err != nil {
+			return nil, err
+		}
+	}
+	pool := newDZIPool(c)
+	if err = makeDZI(pool, false, pages, path.Join(tmp, "dzi"), iiif, rc); err != nil {
+		return nil, err
+	}
+	if err = makeAlphaDZI(pool, pages, path.Join(tmp, "dzi_alpha"), rc); err != nil {
+		return nil, err
+	}
+	pool.StopAndWait()
+	if pool.FailedTasks() > 0 {
+		return nil, errors.New("error on make dzi")
+	}
+
+	if err = makeCovers(pages, path.Join(tmp, "leads"), path.Join(tmp, "covers"), rc); err != nil {
+		return nil, err
+	}
+
+	if err = updateRecolorManifest(manifest, recolor, _tmp); err != nil {
+		return nil, err
+	}
+	if manifest.SwatchFiles, err = exportSwatches(manifest.Swatches, tmp, _tmp, manifest.Basename); err != nil {
+		return nil, err
+	}
+
+	if err = saveManifest(manifest, tmp); err != nil {
+		return nil, err
+	}
+
+	if !c.DebugMode {
+		// B-W separations are unchanged and already stored
+		log.Println("[-] Remove B-W channels folder")
+		if err = os.RemoveAll(channelsBw); err != nil {
+			return nil, err
+		}
+		if !c.CopyChannelsToS3 {
+			log.Println("[-] Remove Color channels folder")
+			if err = os.RemoveAll(channels); err != nil {
+				return nil, err
+			}
+			log.Println("[-] Remove alpha channels folder")
+			if err = os.RemoveAll(channelsAlpha); err != nil {
+				return nil, err
+			}
+		}
+		if err = syncToS3(assetId, tmp, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// recolorConfig return copy of the config with tile and substrate settings of the stored asset,
+// so rebuilt DZI match the others. Manifest keeps only the file name of the substrate texture,
+// so the configured texture must be the same file.
+func recolorConfig(manifest *Manifest, c *Config) (*Config, error) {
+	rc := *c
+	rc.TileSize = manifest.TileSize
+	rc.TileFormat = manifest.TileFormat
+	rc.Overlap = manifest.Overlap
+	rc.CoverHeight = manifest.CoverHeight
+	rc.SubstrateColor = ""
+	rc.SubstrateTexture = ""
+	if manifest.Substrate == nil {
+		return &rc, nil
+	}
+
+	rc.SubstrateColor = manifest.Substrate.Color
+	if texture := manifest.Substrate.Texture; texture != "" {
+		if c.SubstrateTexture == "" || path.Base(c.SubstrateTexture) != texture {
+			return nil, fmt.Errorf("asset was processed with substrate texture %q, configured texture is %q", texture, c.SubstrateTexture)
+		}
+		rc.SubstrateTexture = c.SubstrateTexture
+	}
+	return &rc, nil
+}
+
+// recolorChannels find separations of the swatches on all pages of the manifest
+func recolorChannels(manifest *Manifest, colors map[string]string) ([]*recolorChannel, error) {
+	result := make([]*recolorChannel, 0)
+	for name, hex := range colors {
+		color, err := colorful.Hex(hex)
+		if err != nil {
+			return nil, fmt.Errorf("color of %s: %w", name, err)
+		}
+		idx := slices.IndexFunc(manifest.Swatches, func(s *Swatch) bool {
+			return s.Name == name && s.NeedMate
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf("swatch %q not found", name)
+		}
+		swatch := manifest.Swatches[idx]
+
+		var found bool
+		for _, page := range manifest.Pages {
+			for _, channel := range page.ChannelsV4 {
+				if channel.Name != name || channel.Variant != "" || channel.DziColorPath == "" {
+					continue
+				}
+				found = true
+				filename := strings.TrimSuffix(path.Base(channel.DziColorPath), ".zip")
+				result = append(result, &recolorChannel{
+					page:    page,
+					channel: channel,
+					swatch: &Swatch{
+						Filepath:     fmt.Sprintf("%s.tiff", filename),
+						Name:         swatch.Name,
+						RBG:          color.Hex(),
+						Type:         swatch.Type,
+						NeedMate:     true,
+						Role:         swatch.Role,
+						DziAlphaPath: channel.DziAlphaPath,
+					},
+				})
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("swatch %q has no color channels", name)
+		}
+	}
+	return result, nil
+}
+
+// updateRecolorManifest write new colors, ranges and covers of recolorized channels into manifest.
+// Color values of the source document are dropped, the preview color is now set by the customer.
+func updateRecolorManifest(manifest *Manifest, recolor []*recolorChannel, tmpRoot string) error {
+	for _, item := range recolor {
+		s := item.swatch
+		lab := hex2lab(s.RBG)
+
+		idx := slices.IndexFunc(manifest.Swatches, func(sw *Swatch) bool {
+			return sw.Name == s.Name
+		})
+		swatch := manifest.Swatches[idx]
+		swatch.RBG = s.RBG
+		swatch.Lab = lab
+		swatch.Cmyk = nil
+		swatch.Source = SourceRecolor
+		swatch.Match = nil
+
+		channel := item.channel
+		channel.ColorRanges = s.DziColorRanges
+		if s.IIIFColorPath != "" {
+			channel.IIIFColorPath = strings.TrimPrefix(s.IIIFColorPath, tmpRoot)
+		}
+		channel.LeadPath = strings.TrimPrefix(s.LeadPath, tmpRoot)
+		channel.CoverPath = strings.TrimPrefix(s.CoverPath, tmpRoot)
+		channel.Lab = lab
+		channel.Cmyk = nil
+		channel.Source = SourceRecolor
+		channel.Match = nil
+
+		if err := writeRanges(tmpRoot, channel.AlphaRangesPath, s.DziAlphaRanges); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,6 +35,8 @@ type pageSize struct {
 
 	Dpi    int
 	Rotate float64
+
+	Layers []*pdfLayer
 }
 
 // getPagesDimensions collect pages dimensions and spots colors from PDF file
@@ -268,8 +271,16 @@ func getPagesDimensions(fileName string, c *Config) ([]*pageSize, error) {
 		}
 	}
 
+	// Collect optional content groups (layers)
+	layers, err := getPagesLayers(fileName)
+	if err != nil {
+		log.Printf("[!] Error collecting layers: %v", err)
+	}
+
 	// Map spots to pages
 	for _, page := range pages {
+		page.Layers = layers[page.PageNum]
+
 		for _, line := range filteredLines {
 			if strings.HasPrefix(line, fmt.Sprintf("Page %d", page.PageNum)) {
 				triplet := strings.Split(line, "\t")
@@ -289,7 +300,7 @@ func getPagesDimensions(fileName string, c *Config) ([]*pageSize, error) {
 	return pages, nil
 }
 
-func renderPdf(fileName, outputPrefix, basename string, c *Config) ([]*pageSize, pageChannels, error) {
+func renderPdf(fileName, outputPrefix, basename string, resolver *colorResolver, c *Config) ([]*pageSize, pageChannels, error) {
 
 	st := time.Now()
 	defer func() {
@@ -302,6 +313,11 @@ func renderPdf(fileName, outputPrefix, basename string, c *Config) ([]*pageSize,
 
 	log.Println("[!] Pages count:", len(pages))
 
+	proofs, err := proofProfiles(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	splitChannels := c.SplitChannels
 
 	panicHandler := func(p interface{}) {
@@ -326,26 +342,37 @@ func renderPdf(fileName, outputPrefix, basename string, c *Config) ([]*pageSize,
 				panic(err)
 			}
 			var spots channelsMap
+			var err error
 
 			if splitChannels {
 				outputFilepath := fmt.Sprintf("%s/%s.tiff", outputFolder, basename)
-				if spots, err = callGS(fileName, outputFilepath, page, "tiffsep", c); err != nil {
+				if spots, err = callGS(fileName, outputFilepath, page, "tiffsep", c.Overprint, resolver, c); err != nil {
 					panic(err)
 				}
-				var overprint = c.Overprint
-				if c.Overprint != "/simulate" {
-					c.Overprint = ""
+				if _, err = runGS(fileName, outputFilepath, page, "tiff32nc", compositeOverprint("tiff32nc", c.Overprint), c); err != nil {
+					panic(err)
 				}
-				if _, err = callGS(fileName, outputFilepath, page, "tiff32nc", c); err != nil {
+				if err = renderProofVariants(outputFolder, basename, outputFilepath, proofs, spots, c); err != nil {
+					panic(err)
+				}
+				if err = renderOverprintVariants(fileName, outputFolder, basename, page, spots, c); err != nil {
 					panic(err)
 				}
-				c.Overprint = overprint
 			} else {
 				outputFilepath := fmt.Sprintf("%s/%s.png", outputFolder, basename)
-				if spots, err = callGS(fileName, outputFilepath, page, "png16m", c); err != nil {
+				if spots, err = callGS(fileName, outputFilepath, page, "png16m", c.Overprint, resolver, c); err != nil {
+					panic(err)
+				}
+				if err = renderOverprintVariants(fileName, outputFolder, basename, page, spots, c); err != nil {
 					panic(err)
 				}
 			}
+			if err = renderLayerVariants(fileName, outputFolder, basename, page, spots, c); err != nil {
+				panic(err)
+			}
+			if color, ok := spots["Color"]; ok {
+				color.Overprint = c.Overprint
+			}
 			backupSpotsMutex.Lock()
 			backupSpots[page.PageNum] = spots
 			backupSpotsMutex.Unlock()
@@ -360,3 +387,69 @@ func renderPdf(fileName, outputPrefix, basename string, c *Config) ([]*pageSize,
 	}
 	return pages, backupSpots, nil
 }
+
+// compositeDevice returns the Ghostscript device of the color composite
+func compositeDevice(c *Config) string {
+	if c.SplitChannels {
+		return "tiff32nc"
+	}
+	return "png16m"
+}
+
+// compositeOverprint returns the overprint mode passed to Ghostscript for the composite on the device.
+// tiff32nc gets only simulation, other modes are left to the Ghostscript default.
+func compositeOverprint(device, overprint string) string {
+	if device == "tiff32nc" && overprint != OverprintSimulate {
+		return ""
+	}
+	return overprint
+}
+
+// overprintVariants returns the overprint modes which should be rendered besides the primary one.
+// Modes giving the same Ghostscript overprint for the composite as the primary or an earlier mode
+// are skipped.
+func overprintVariants(c *Config) []string {
+	device := compositeDevice(c)
+	rendered := []string{compositeOverprint(device, c.Overprint)}
+	variants := make([]string, 0)
+	for _, mode := range c.OverprintModes {
+		if mode == "" || mode == c.Overprint || slices.Contains(variants, mode) {
+			continue
+		}
+		effective := compositeOverprint(device, mode)
+		if slices.Contains(rendered, effective) {
+			continue
+		}
+		rendered = append(rendered, effective)
+		variants = append(variants, mode)
+	}
+	return variants
+}
+
+// renderOverprintVariants renders an extra composite for each additional overprint mode and
+// registers it as a color channel of the page. Variants are rendered with the same device and
+// overprint rules as the primary composite, without separations.
+func renderOverprintVariants(fileName, outputFolder, basename string, page *pageSize, spots channelsMap, c *Config) error {
+	device := compositeDevice(c)
+	ext := "tiff"
+	if device == "png16m" {
+		ext = "png"
+	}
+
+	for _, mode := range overprintVariants(c) {
+		opsName := fmt.Sprintf("Color_%s", strings.TrimPrefix(mode, "/"))
+		outputFilepath := fmt.Sprintf("%s/%s(%s).%s", outputFolder, basename, opsName, ext)
+		if _, err := runGS(fileName, outputFilepath, page, device, compositeOverprint(device, mode), c); err != nil {
+			return err
+		}
+
+		spots[fmt.Sprintf("Color (%s)", strings.TrimPrefix(mode, "/"))] = &channelFile{
+			Filepath:  outputFilepath,
+			OpsName:   opsName,
+			IsColor:   true,
+			Variant:   VariantOverprint,
+			Overprint: mode,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package dzi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// Retile rebuild DZI pyramids and covers of the processed asset from stored channels with tile settings
+// of the config (TileSize, TileFormat, TileSetting, Overlap, CoverHeight), so the source is not rendered again.
+// Channels are stored only when the asset was processed with CopyChannelsToS3.
+func Retile(assetId int, c *Config) (*Manifest, error) {
+	st := time.Now()
+	log.Printf("[>] Retile asset %d", assetId)
+	defer func() {
+		log.Printf("[<] Retile asset %d, at %s", assetId, time.Since(st))
+	}()
+
+	_tmp, tmp := assetFolders(assetId, c)
+	channels := path.Join(tmp, "channels")
+	channelsBw := path.Join(tmp, "channels_bw")
+	channelsAlpha := path.Join(tmp, "channels_alpha")
+	dzi := path.Join(tmp, "dzi")
+	dziBw := path.Join(tmp, "dzi_bw")
+	dziAlpha := path.Join(tmp, "dzi_alpha")
+
+	if !c.DebugMode {
+		if err := prepareTopFolders(tmp); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := os.RemoveAll(tmp); err != nil {
+				log.Printf("Error removing directory: %v", tmp)
+			}
+		}()
+	}
+
+	manifest, err := loadManifest(assetId, tmp, c)
+	if err != nil {
+		return nil, err
+	}
+	if !c.DebugMode {
+		items := []string{"channels/", "channels_bw/"}
+		if manifest.AlphaFormat != "" {
+			items = append(items, "channels_alpha/")
+		}
+		if manifest.OutputIntent != "" {
+			items = append(items, path.Base(manifest.OutputIntent))
+		}
+		if err = fetchFromS3(assetId, tmp, c, items...); err != nil {
+			return nil, fmt.Errorf("channels are not stored, asset must be processed with DZI_COPY_CHANNELS=true: %w", err)
+		}
+	}
+
+	var inputProfile string
+	if manifest.OutputIntent != "" {
+		inputProfile = path.Join(_tmp, manifest.OutputIntent)
+	}
+	pages, err := retilePages(manifest, channels, channelsAlpha, inputProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pyramids of the previous settings are replaced completely
+	for _, folder := range []string{dzi, dziBw, dziAlpha, path.Join(tmp, "iiif"), path.Join(tmp, "iiif_bw")} {
+		if err = os.RemoveAll(folder); err != nil {
+			return nil, err
+		}
+	}
+	if err = prepareTopFolders(dzi, dziBw, dziAlpha); err != nil {
+		return nil, err
+	}
+
+	var iiif, iiifBw *iiifTarget
+	if c.IIIF {
+		if iiif, err = newIIIFTarget(tmp, "iiif", assetId, c); err != nil {
+			return nil, err
+		}
+		if iiifBw, err = newIIIFTarget(tmp, "iiif_bw", assetId, c); err != nil {
+			return nil, err
+		}
+	}
+	pool := newDZIPool(c)
+	if err = makeDZI(pool, false, pages, dzi, iiif, c); err != nil {
+		return nil, err
+	}
+	if err = makeDZI(pool, true, pages, dziBw, iiifBw, c); err != nil {
+		return nil, err
+	}
+	if err = makeAlphaDZI(pool, pages, dziAlpha, c); err != nil {
+		return nil, err
+	}
+	pool.StopAndWait()
+	if pool.FailedTasks() > 0 {
+		return nil, errors.New("error on make dzi")
+	}
+
+	if err = makeCovers(pages, path.Join(tmp, "leads"), path.Join(tmp, "covers"), c); err != nil {
+		return nil, err
+	}
+
+	if err = updateRetileManifest(manifest, pages, _tmp, c); err != nil {
+		return nil, err
+	}
+	if err = saveManifest(manifest, tmp); err != nil {
+		return nil, err
+	}
+
+	if !c.DebugMode {
+		// Stored channels are unchanged, only pyramids, covers and manifest are uploaded
+		for _, folder := range []string{channels, channelsBw, channelsAlpha} {
+			if err = os.RemoveAll(folder); err != nil {
+				return nil, err
+			}
+		}
+		if err = syncToS3(assetId, tmp, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// RetileAssets retile assets one by one for migration to new tile settings. Failed assets are logged
+// and skipped, their errors are returned together.
+func RetileAssets(assetIds []int, c *Config) error {
+	st := time.Now()
+	var errs []error
+	for idx, assetId := range assetIds {
+		log.Printf("[*] Retile %d of %d", idx+1, len(assetIds))
+		if _, err := Retile(assetId, c); err != nil {
+			log.Printf("[!] Retile asset %d: %v", assetId, err)
+			errs = append(errs, fmt.Errorf("asset %d: %w", assetId, err))
+		}
+	}
+	log.Printf("[***] Retiled %d of %d assets in %s", len(assetIds)-len(errs), len(assetIds), time.Since(st))
+	return errors.Join(errs...)
+}
+
+// retilePages restore pages and swatches of the manifest with paths of the stored channels,
+// CMYK composites are converted with the output intent profile when the asset has one
+func retilePages(manifest *Manifest, channels, channelsAlpha, inputProfile string) ([]*pageInfo, error) {
+	pages := make([]*pageInfo, 0, len(manifest.Pages))
+	for _, page := range manifest.Pages {
+		info := &pageInfo{
+			PageNumber:   page.PageNum,
+			ColorMode:    ColorMode(page.Mode),
+			InputProfile: inputProfile,
+		}
+		for _, channel := range page.ChannelsV4 {
+			if channel.DziColorPath == "" {
+				continue
+			}
+			info.Prefix = path.Base(path.Dir(channel.DziColorPath))
+			filename := strings.TrimSuffix(path.Base(channel.DziColorPath), ".zip")
+
+			// Color channels are PNG, JPEG or CMYK TIFF composites
+			files, err := os.ReadDir(path.Join(channels, info.Prefix))
+			if err != nil {
+				return nil, err
+			}
+			var filepath string
+			for _, file := range files {
+				if strings.TrimSuffix(file.Name(), path.Ext(file.Name())) == filename {
+					filepath = path.Join(channels, info.Prefix, file.Name())
+					break
+				}
+			}
+			if filepath == "" {
+				return nil, fmt.Errorf("channel %s of page %d is not stored", channel.Name, page.PageNum)
+			}
+
+			swatch := &Swatch{
+				Filepath: filepath,
+				Name:     channel.Name,
+				Variant:  channel.Variant,
+			}
+			if channel.DziAlphaPath != "" {
+				swatch.AlphaFilepath = path.Join(channelsAlpha, info.Prefix, fmt.Sprintf("%s.png", filename))
+			}
+			info.Swatches = append(info.Swatches, swatch)
+		}
+		pages = append(pages, info)
+	}
+	return pages, nil
+}
+
+// updateRetileManifest write tile settings, ranges and covers of rebuilt pyramids into manifest
+func updateRetileManifest(manifest *Manifest, pages []*pageInfo, tmpRoot string, c *Config) error {
+	manifest.TileSize = c.TileSize
+	manifest.TileFormat = c.TileFormat
+	manifest.Overlap = c.Overlap
+	manifest.CoverHeight = c.CoverHeight
+	if manifest.AlphaFormat != "" {
+		manifest.AlphaFormat = alphaTileFormat(c)
+	}
+
+	for idx, page := range manifest.Pages {
+		var swatchIdx int
+		for _, channel := range page.ChannelsV4 {
+			if channel.DziColorPath == "" {
+				continue
+			}
+			swatch := pages[idx].Swatches[swatchIdx]
+			swatchIdx++
+
+			channel.ColorRanges = swatch.DziColorRanges
+			channel.IIIFColorPath = strings.TrimPrefix(swatch.IIIFColorPath, tmpRoot)
+			channel.IIIFBWPath = strings.TrimPrefix(swatch.IIIFBWPath, tmpRoot)
+			channel.LeadPath = strings.TrimPrefix(swatch.LeadPath, tmpRoot)
+			channel.CoverPath = strings.TrimPrefix(swatch.CoverPath, tmpRoot)
+			if err := writeRanges(tmpRoot, channel.BwRangesPath, swatch.DziBWRanges); err != nil {
+				return err
+			}
+			if err := writeRanges(tmpRoot, channel.AlphaRangesPath, swatch.DziAlphaRanges); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeRanges write tile ranges into the ranges file of the manifest path
+func writeRanges(tmpRoot, rangesPath string, ranges map[string]ZipRange) error {
+	if rangesPath == "" || len(ranges) == 0 {
+		return nil
+	}
+	buffer, err := json.Marshal(ranges)
+	if err != nil {
+		return err
+	}
+	rangesPath = path.Join(tmpRoot, rangesPath)
+	if err = os.MkdirAll(path.Dir(rangesPath), DefaultFolderPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(rangesPath, buffer, 0644)
+}
@@ -10,12 +10,35 @@ var rgbColorModes = []string{"srgb", "rgb", "rgb16"}
 var allColorModes = append([]string{"cmyk"}, rgbColorModes...)
 
 type SwatchType string
+type SwatchSource string
 type ColorMode string
 
 const (
 	CmykComponent SwatchType = "CmykComponent"
 	SpotComponent SwatchType = "SpotComponent"
 	Final         SwatchType = "Final"
+	Analysis      SwatchType = "Analysis"
+)
+
+// Variants of the composite channel
+const (
+	VariantOverprint = "overprint"
+	VariantLayers    = "layers"
+	VariantTAC       = "tac"
+	VariantProof     = "proof"
+	VariantCVD       = "cvd"
+	VariantScreened  = "screened"
+)
+
+// Sources of swatch colors
+const (
+	SourceEsko       SwatchSource = "esko"
+	SourceXMP        SwatchSource = "xmp"
+	SourceSeparation SwatchSource = "separation"
+	SourceLibrary    SwatchSource = "library"
+	SourceCustomer   SwatchSource = "customer_library"
+	SourceImage      SwatchSource = "image"
+	SourceRecolor    SwatchSource = "recolor"
 )
 
 const (
@@ -35,14 +58,53 @@ type Swatch struct {
 	RBG            string              `json:"rgb"`
 	Type           SwatchType          `json:"type"`
 	NeedMate       bool                `json:"need_mate"`
+	Role           InkRole             `json:"role,omitempty"`
+	Coverage       *InkCoverage        `json:"coverage,omitempty"`
+	Library        string              `json:"library,omitempty"`
+	LibraryName    string              `json:"library_name,omitempty"`
+	NameConfidence float64             `json:"name_confidence,omitempty"`
+	RawName        string              `json:"raw_name,omitempty"`
+	Charset        string              `json:"charset,omitempty"`
+	Lab            []float64           `json:"lab,omitempty"`
+	Cmyk           []float64           `json:"cmyk,omitempty"`
+	Source         SwatchSource        `json:"source,omitempty"`
+	Book           string              `json:"book,omitempty"`
+	Group          string              `json:"group,omitempty"`
+	Tints          []float64           `json:"tints,omitempty"`
+	MixedInks      []string            `json:"mixed_inks,omitempty"`
+	Ink            *InkAttributes      `json:"ink,omitempty"`
+	Match          *SwatchMatch        `json:"match,omitempty"`
+	PageCoverage   *InkCoverage        `json:"-"`
+	Variant        string              `json:"-"`
+	Overprint      string              `json:"-"`
+	Layers         []string            `json:"-"`
+	Profile        string              `json:"-"`
+	Simulation     string              `json:"-"`
+	Screen         *Screen             `json:"-"`
+	ExcludedRoles  []InkRole           `json:"-"`
 	DziColorPath   string              `json:"-"`
 	DziColorRanges map[string]ZipRange `json:"-"`
 	DziBWPath      string              `json:"-"`
 	DziBWRanges    map[string]ZipRange `json:"-"`
+	IIIFColorPath  string              `json:"-"`
+	IIIFBWPath     string              `json:"-"`
+	AlphaFilepath  string              `json:"-"`
+	DziAlphaPath   string              `json:"-"`
+	DziAlphaRanges map[string]ZipRange `json:"-"`
 	LeadPath       string              `json:"-"`
 	CoverPath      string              `json:"-"`
 }
 
+// InkAttributes are press attributes of the ink from Esko metadata
+type InkAttributes struct {
+	Type     string   `json:"type,omitempty"`
+	Opacity  *float64 `json:"opacity,omitempty"`
+	Sequence *int     `json:"sequence,omitempty"`
+	Angle    *float64 `json:"angle,omitempty"`
+	Ruling   *float64 `json:"ruling,omitempty"`
+	DotShape string   `json:"dot_shape,omitempty"`
+}
+
 func (s Swatch) Basename() string {
 	return filepath.Base(s.Filepath)
 }
@@ -53,30 +115,51 @@ func (s Swatch) Filename() string {
 }
 
 type pageInfo struct {
-	Prefix      string
-	PageNumber  int
-	Width       float64
-	Height      float64
-	ColorMode   ColorMode
-	Unit        string
-	Swatches    []*Swatch
-	TextContent string
-	Dpi         int
+	Prefix       string
+	PageNumber   int
+	Width        float64
+	Height       float64
+	ColorMode    ColorMode
+	Unit         string
+	Swatches     []*Swatch
+	TextContent  string
+	Dpi          int
+	Layers       []*pdfLayer
+	MaxTAC       float64
+	InputProfile string
+}
+
+// cmykProfile return input profile of CMYK renders of the page: output intent of the document
+// or the generic CMYK profile of libvips
+func (p pageInfo) cmykProfile() string {
+	if p.InputProfile != "" {
+		return p.InputProfile
+	}
+	return "cmyk"
 }
 
 type pdfEgMeta struct {
-	Unit string  `xml:"RDF>Description>units"`
-	W    float64 `xml:"RDF>Description>vsize"`
-	H    float64 `xml:"RDF>Description>hsize"`
-	Inks []struct {
-		Name   string  `xml:"name"`
-		Type   string  `xml:"type"`
-		Book   string  `xml:"book"`
-		EGName string  `xml:"egname"`
-		R      float64 `xml:"r"`
-		G      float64 `xml:"g"`
-		B      float64 `xml:"b"`
-	} `xml:"RDF>Description>inks>Seq>li"`
+	Unit string    `xml:"RDF>Description>units"`
+	W    float64   `xml:"RDF>Description>vsize"`
+	H    float64   `xml:"RDF>Description>hsize"`
+	Inks []eskoInk `xml:"RDF>Description>inks>Seq>li"`
+}
+
+// eskoInk is an ink of Esko metadata, attributes are optional and set by Esko Ink Manager
+type eskoInk struct {
+	Name     string   `xml:"name"`
+	Type     string   `xml:"type"`
+	Book     string   `xml:"book"`
+	EGName   string   `xml:"egname"`
+	R        float64  `xml:"r"`
+	G        float64  `xml:"g"`
+	B        float64  `xml:"b"`
+	InkType  string   `xml:"inktype"`
+	Opacity  *float64 `xml:"opacity"`
+	Sequence *int     `xml:"sequence"`
+	Angle    *float64 `xml:"angle"`
+	Ruling   *float64 `xml:"ruling"`
+	DotShape string   `xml:"dotshape"`
 }
 
 type pdfMeta struct {
@@ -85,18 +168,26 @@ type pdfMeta struct {
 	Unit         string   `xml:"RDF>Description>MaxPageSize>unit"`
 	PlateNames   []string `xml:"RDF>Description>PlateNames>Seq>li"`
 	SwatchGroups []struct {
-		SwatchName string  `xml:"swatchName"`
-		Type       string  `xml:"type"`
-		Mode       string  `xml:"mode"`
-		L          float64 `xml:"L"`
-		A          float64 `xml:"A"`
-		B          float64 `xml:"B"`
-		Cyan       float64 `xml:"cyan"`
-		Magenta    float64 `xml:"magenta"`
-		Yellow     float64 `xml:"yellow"`
-		Black      float64 `xml:"black"`
-		Red        int     `xml:"red"`
-		Green      int     `xml:"green"`
-		Blue       int     `xml:"blue"`
-	} `xml:"RDF>Description>SwatchGroups>Seq>li>Colorants>Seq>li"`
+		GroupName string        `xml:"groupName"`
+		Colorants []xmpColorant `xml:"Colorants>Seq>li"`
+	} `xml:"RDF>Description>SwatchGroups>Seq>li"`
+}
+
+type xmpColorant struct {
+	SwatchName string   `xml:"swatchName"`
+	Type       string   `xml:"type"`
+	Tint       *float64 `xml:"tint"`
+	Mode       string   `xml:"mode"`
+	L          float64  `xml:"L"`
+	A          float64  `xml:"A"`
+	B          float64  `xml:"B"`
+	Cyan       float64  `xml:"cyan"`
+	Magenta    float64  `xml:"magenta"`
+	Yellow     float64  `xml:"yellow"`
+	Black      float64  `xml:"black"`
+	Red        int      `xml:"red"`
+	Green      int      `xml:"green"`
+	Blue       int      `xml:"blue"`
+	// Components of mixed ink swatch with their tints
+	Components []xmpColorant `xml:"Colorants>Seq>li"`
 }
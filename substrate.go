@@ -0,0 +1,259 @@
+package dzi
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"time"
+
+	"github.com/davidbyttow/govips/v2/vips"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// whiteInkBackdrop used for White channel preview when no substrate configured
+const whiteInkBackdrop = "#3c3c3c"
+
+type Substrate struct {
+	Color   string `json:"color,omitempty"`
+	Texture string `json:"texture,omitempty"`
+}
+
+// substrateInfo describe substrate for manifest, nil for plain white paper
+func substrateInfo(c *Config) *Substrate {
+	if c.SubstrateColor == "" && c.SubstrateTexture == "" {
+		return nil
+	}
+	substrate := &Substrate{Color: c.SubstrateColor}
+	if c.SubstrateTexture != "" {
+		substrate.Texture = path.Base(c.SubstrateTexture)
+	}
+	return substrate
+}
+
+// bwFilepath return path of the grayscale copy of the swatch made by processSwatch
+func bwFilepath(swatch *Swatch, bwFolder string) string {
+	return path.Join(bwFolder, fmt.Sprintf("%s.tiff", swatch.Filename()))
+}
+
+// createSubstrate return substrate image with a certain width and height.
+// Texture is tiled over the page, otherwise substrate color or backdrop color used.
+func createSubstrate(w, h int, backdrop string, c *Config) (*vips.ImageRef, error) {
+	if c.SubstrateTexture != "" {
+		ref, err := vips.LoadImageFromFile(c.SubstrateTexture, nil)
+		if err != nil {
+			return nil, err
+		}
+		if ref.HasAlpha() {
+			if err = ref.Flatten(&vips.Color{R: 255, G: 255, B: 255}); err != nil {
+				ref.Close()
+				return nil, err
+			}
+		}
+		if err = ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
+			ref.Close()
+			return nil, err
+		}
+		across := (w + ref.Width() - 1) / ref.Width()
+		down := (h + ref.Height() - 1) / ref.Height()
+		if err = ref.Replicate(across, down); err != nil {
+			ref.Close()
+			return nil, err
+		}
+		if err = ref.ExtractArea(0, 0, w, h); err != nil {
+			ref.Close()
+			return nil, err
+		}
+		return ref, nil
+	}
+
+	if c.SubstrateColor != "" {
+		backdrop = c.SubstrateColor
+	}
+	color, err := colorful.Hex(backdrop)
+	if err != nil {
+		return nil, err
+	}
+	return createImage(w, h, color)
+}
+
+// inkOverlay return solid ink color image with alpha band made from ink density of the grayscale separation
+func inkOverlay(bwPath, hex string) (*vips.ImageRef, error) {
+	density, err := vips.LoadImageFromFile(bwPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer density.Close()
+
+	if density.Bands() > 1 {
+		if err = density.ExtractBand(0, 1); err != nil {
+			return nil, err
+		}
+	}
+	if err = density.Invert(); err != nil {
+		return nil, err
+	}
+
+	color, err := colorful.Hex(hex)
+	if err != nil {
+		return nil, err
+	}
+	overlay, err := createImage(density.Width(), density.Height(), color)
+	if err != nil {
+		return nil, err
+	}
+	if err = overlay.BandJoin(density); err != nil {
+		overlay.Close()
+		return nil, err
+	}
+	return overlay, nil
+}
+
+// applySubstrate place colorized separations and composites over the substrate.
+// White ink is drawn opaque over the substrate (or dark backdrop), other inks are multiplied.
+func applySubstrate(page *pageInfo, bwFolder string, c *Config) error {
+	hasSubstrate := substrateInfo(c) != nil
+
+	var white *Swatch
+	for _, swatch := range page.Swatches {
+		if swatch.NeedMate && swatch.Role == InkRoleWhite {
+			white = swatch
+			break
+		}
+	}
+	if !hasSubstrate && white == nil {
+		return nil
+	}
+
+	st := time.Now()
+	log.Printf("[>] Apply substrate page %d", page.PageNumber)
+	defer func() {
+		log.Printf("[<] Apply substrate page %d, at %s", page.PageNumber, time.Since(st))
+	}()
+
+	for _, swatch := range page.Swatches {
+		var err error
+		switch {
+		case swatch == white:
+			err = substrateWhiteInk(swatch, bwFolder, c)
+		case !hasSubstrate:
+			continue
+		// Proofs simulate paper white of the press condition
+		case swatch.Type == Final && swatch.Variant != VariantProof:
+			err = substrateComposite(page, swatch, white, bwFolder, c)
+		case swatch.NeedMate && page.ColorMode == ColorModeCMYK:
+			err = substratePlate(swatch, c)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// substrateWhiteInk draw white ink over the substrate or the dark backdrop
+func substrateWhiteInk(swatch *Swatch, bwFolder string, c *Config) error {
+	overlay, err := inkOverlay(bwFilepath(swatch, bwFolder), swatch.RBG)
+	if err != nil {
+		return err
+	}
+	defer overlay.Close()
+
+	ref, err := createSubstrate(overlay.Width(), overlay.Height(), whiteInkBackdrop, c)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	if err = ref.Composite(overlay, vips.BlendModeOver, 0, 0); err != nil {
+		return err
+	}
+	return writeSubstrateResult(ref, swatch)
+}
+
+// substratePlate multiply colorized separation with the substrate
+func substratePlate(swatch *Swatch, c *Config) error {
+	plate, err := vips.LoadImageFromFile(swatch.Filepath, nil)
+	if err != nil {
+		return err
+	}
+	defer plate.Close()
+
+	ref, err := createSubstrate(plate.Width(), plate.Height(), "", c)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	if err = ref.Composite(plate, vips.BlendModeMultiply, 0, 0); err != nil {
+		return err
+	}
+	return writeSubstrateResult(ref, swatch)
+}
+
+// substrateComposite multiply composite with the substrate lightened by white ink underprint.
+// CMYK composites are converted with the input profile of the page.
+func substrateComposite(page *pageInfo, swatch, white *Swatch, bwFolder string, c *Config) error {
+	composite, err := vips.LoadImageFromFile(swatch.Filepath, nil)
+	if err != nil {
+		return err
+	}
+	defer composite.Close()
+
+	if composite.ColorSpace() == vips.InterpretationCMYK {
+		if err = composite.TransformICCProfileWithFallback(c.ICCProfileFilepath, page.cmykProfile()); err != nil {
+			return err
+		}
+	} else if err = composite.ToColorSpace(vips.InterpretationSRGB); err != nil {
+		return err
+	}
+	if composite.HasAlpha() {
+		if err = composite.Flatten(&vips.Color{R: 255, G: 255, B: 255}); err != nil {
+			return err
+		}
+	}
+
+	ref, err := createSubstrate(composite.Width(), composite.Height(), "", c)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	if white != nil {
+		overlay, err := inkOverlay(bwFilepath(white, bwFolder), "#ffffff")
+		if err != nil {
+			return err
+		}
+		err = ref.Composite(overlay, vips.BlendModeOver, 0, 0)
+		overlay.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = ref.Composite(composite, vips.BlendModeMultiply, 0, 0); err != nil {
+		return err
+	}
+	return writeSubstrateResult(ref, swatch)
+}
+
+// writeSubstrateResult save result as PNG and replace swatch file
+func writeSubstrateResult(ref *vips.ImageRef, swatch *Swatch) error {
+	if ref.HasAlpha() {
+		if err := ref.ExtractBand(0, ref.Bands()-1); err != nil {
+			return err
+		}
+	}
+
+	outputFilepath := path.Join(path.Dir(swatch.Filepath), fmt.Sprintf("%s.png", swatch.Filename()))
+	if err := toPng(ref, outputFilepath); err != nil {
+		return err
+	}
+	if outputFilepath != swatch.Filepath {
+		if err := os.Remove(swatch.Filepath); err != nil {
+			return err
+		}
+	}
+	swatch.Filepath = outputFilepath
+	return nil
+}
@@ -0,0 +1,78 @@
+package dzi
+
+import (
+	"log"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/brandquad/dzi/assets"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+type SwatchFiles struct {
+	ASE string `json:"ase"`
+	CxF string `json:"cxf"`
+}
+
+// swatchesBook collect ink swatches of the asset into a book, process inks keep their CMYK values
+func swatchesBook(swatches []*Swatch, title string) *assets.Book {
+	book := &assets.Book{Title: title, ColorModel: assets.ColorModelLab}
+	for _, s := range swatches {
+		if !s.NeedMate || s.Lab == nil {
+			continue
+		}
+		color, err := colorful.Hex(s.RBG)
+		if err != nil {
+			continue
+		}
+		r, g, b := color.RGB255()
+		bookColor := &assets.BookColor{
+			Name:       s.Name,
+			ColorModel: assets.ColorModelLab,
+			Components: s.Lab,
+			Lab:        s.Lab,
+			RGB:        []int{int(r), int(g), int(b)},
+			CMYK:       s.Cmyk,
+		}
+		if s.Type == CmykComponent && len(s.Cmyk) == 4 {
+			bookColor.ColorModel = assets.ColorModelCMYK
+		}
+		book.Colors = append(book.Colors, bookColor)
+	}
+	return book
+}
+
+// exportSwatches write asset swatches as swatches.ase and swatches.cxf into outputFolder,
+// returned paths are relative to tmpRoot as other manifest paths
+func exportSwatches(swatches []*Swatch, outputFolder, tmpRoot, basename string) (*SwatchFiles, error) {
+	book := swatchesBook(swatches, basename)
+	if len(book.Colors) == 0 {
+		return nil, nil
+	}
+	log.Printf("[*] Export %d swatches", len(book.Colors))
+
+	ase, err := assets.EncodeASE(book)
+	if err != nil {
+		return nil, err
+	}
+	asePath := path.Join(outputFolder, "swatches.ase")
+	if err = os.WriteFile(asePath, ase, 0644); err != nil {
+		return nil, err
+	}
+
+	cxf, err := assets.EncodeCxF(book, "dzi", time.Now().Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	cxfPath := path.Join(outputFolder, "swatches.cxf")
+	if err = os.WriteFile(cxfPath, cxf, 0644); err != nil {
+		return nil, err
+	}
+
+	return &SwatchFiles{
+		ASE: strings.TrimPrefix(asePath, tmpRoot),
+		CxF: strings.TrimPrefix(cxfPath, tmpRoot),
+	}, nil
+}
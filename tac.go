@@ -0,0 +1,266 @@
+package dzi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidbyttow/govips/v2/vips"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// DefaultInkLimit is the total area coverage limit used when Config.InkLimit is not set
+const DefaultInkLimit = 300.0
+
+// tacRoles inks counted in total area coverage. White underprint is not limited by the process ink
+// limit, so only printing inks are summed.
+var tacRoles = []InkRole{InkRolePrinting}
+
+// tacRampStops color ramp for coverage from zero to the ink limit
+var tacRampStops = []colorful.Color{
+	{R: 1, G: 1, B: 1},
+	{R: 0.2, G: 0.4, B: 1},
+	{R: 0.2, G: 0.8, B: 0.3},
+	{R: 1, G: 0.9, B: 0.1},
+	{R: 1, G: 0.55, B: 0},
+}
+
+// tacRampOverLimit share of the heatmap scale after the ink limit
+const tacRampOverLimit = 0.25
+
+func inkLimit(c *Config) float64 {
+	if c.InkLimit <= 0 {
+		return DefaultInkLimit
+	}
+	return c.InkLimit
+}
+
+// pageComposite return primary composite swatch of the page
+func pageComposite(page *pageInfo) *Swatch {
+	for _, swatch := range page.Swatches {
+		if swatch.Type == Final && swatch.Variant == "" {
+			return swatch
+		}
+	}
+	return nil
+}
+
+// imageStats return statistics for all bands together: minimum, maximum, sum, sum of squares, mean,
+// standard deviation, x and y of minimum, x and y of maximum
+func imageStats(ref *vips.ImageRef) ([]float64, error) {
+	stats, err := ref.Copy()
+	if err != nil {
+		return nil, err
+	}
+	defer stats.Close()
+
+	if err = stats.Stats(); err != nil {
+		return nil, err
+	}
+	buffer, err := stats.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, 10)
+	if err = binary.Read(bytes.NewReader(buffer), binary.NativeEndian, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// loadDensity load grayscale separation as ink density in percents
+func loadDensity(bwPath string) (*vips.ImageRef, error) {
+	ref, err := vips.LoadImageFromFile(bwPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Bands() > 1 {
+		if err = ref.ExtractBand(0, 1); err != nil {
+			ref.Close()
+			return nil, err
+		}
+	}
+	if err = ref.Linear([]float64{-100.0 / 255.0}, []float64{100}); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	return ref, nil
+}
+
+// tacLUT build heatmap look-up table, index 255 means coverage of ink limit * (1 + tacRampOverLimit) and more
+func tacLUT() (*vips.ImageRef, error) {
+	lut := image.NewRGBA(image.Rect(0, 0, 256, 1))
+	limitIndex := 255 / (1 + tacRampOverLimit)
+	segments := float64(len(tacRampStops) - 1)
+
+	for i := 0; i < 256; i++ {
+		var c colorful.Color
+		if float64(i) > limitIndex {
+			c = colorful.Color{R: 0.85, G: 0, B: 0}
+		} else {
+			t := float64(i) / limitIndex * segments
+			idx := min(int(t), len(tacRampStops)-2)
+			c = tacRampStops[idx].BlendRgb(tacRampStops[idx+1], t-float64(idx))
+		}
+		r, g, b := c.RGB255()
+		lut.Set(i, 0, color.RGBA{R: r, G: g, B: b, A: 255})
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, lut); err != nil {
+		return nil, err
+	}
+	ref, err := vips.NewImageFromBuffer(buffer.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if err = ref.ExtractBand(0, 3); err != nil {
+		ref.Close()
+		return nil, err
+	}
+	return ref, nil
+}
+
+// makeTAC sum separations of each page into total area coverage, write heatmap and ink limit mask
+// as additional channels and store max coverage to the page
+func makeTAC(pages []*pageInfo, bwRoot string, c *Config) error {
+	st := time.Now()
+	log.Println("[>] Make TAC")
+	defer func() {
+		log.Println("[<] Make TAC, at", time.Since(st))
+	}()
+
+	limit := inkLimit(c)
+	lut, err := tacLUT()
+	if err != nil {
+		return err
+	}
+	defer lut.Close()
+
+	for _, page := range pages {
+		if page.ColorMode != ColorModeCMYK {
+			continue
+		}
+		composite := pageComposite(page)
+		if composite == nil {
+			continue
+		}
+
+		var tac *vips.ImageRef
+		for _, swatch := range page.Swatches {
+			if !swatch.NeedMate || !slices.Contains(tacRoles, swatch.Role) || strings.EqualFold(swatch.Name, "alpha") {
+				continue
+			}
+			density, err := loadDensity(bwFilepath(swatch, path.Join(bwRoot, page.Prefix)))
+			if err != nil {
+				return err
+			}
+			if tac == nil {
+				tac = density
+				continue
+			}
+			err = tac.Add(density)
+			density.Close()
+			if err != nil {
+				tac.Close()
+				return err
+			}
+		}
+		if tac == nil {
+			continue
+		}
+
+		if err = pageTAC(page, composite, tac, lut, limit); err != nil {
+			tac.Close()
+			return err
+		}
+		tac.Close()
+	}
+	return nil
+}
+
+func pageTAC(page *pageInfo, composite *Swatch, tac, lut *vips.ImageRef, limit float64) error {
+	stats, err := imageStats(tac)
+	if err != nil {
+		return err
+	}
+	page.MaxTAC = stats[1]
+	log.Printf("[*] Max TAC for page %d is %.1f%%", page.PageNumber, page.MaxTAC)
+
+	outputFolder := path.Dir(composite.Filepath)
+
+	// Heatmap
+	heatmap, err := tac.Copy()
+	if err != nil {
+		return err
+	}
+	defer heatmap.Close()
+	if err = heatmap.Linear([]float64{255 / (limit * (1 + tacRampOverLimit))}, []float64{0}); err != nil {
+		return err
+	}
+	if err = heatmap.Cast(vips.BandFormatUchar); err != nil {
+		return err
+	}
+	if err = heatmap.Maplut(lut); err != nil {
+		return err
+	}
+	heatmapPath := path.Join(outputFolder, fmt.Sprintf("%s(TAC).png", composite.Filename()))
+	if err = toPng(heatmap, heatmapPath); err != nil {
+		return err
+	}
+
+	// Mask of areas over the ink limit
+	mask, err := tac.Copy()
+	if err != nil {
+		return err
+	}
+	defer mask.Close()
+	if err = mask.Linear([]float64{1e6}, []float64{-limit * 1e6}); err != nil {
+		return err
+	}
+	if err = mask.Cast(vips.BandFormatUchar); err != nil {
+		return err
+	}
+	maskRef, err := createImage(mask.Width(), mask.Height(), colorful.Color{R: 0.85, G: 0, B: 0})
+	if err != nil {
+		return err
+	}
+	defer maskRef.Close()
+	if err = maskRef.BandJoin(mask); err != nil {
+		return err
+	}
+	if err = maskRef.Flatten(&vips.Color{R: 255, G: 255, B: 255}); err != nil {
+		return err
+	}
+	maskPath := path.Join(outputFolder, fmt.Sprintf("%s(TAC_limit).png", composite.Filename()))
+	if err = toPng(maskRef, maskPath); err != nil {
+		return err
+	}
+
+	page.Swatches = append(page.Swatches,
+		&Swatch{
+			Filepath: heatmapPath,
+			Name:     "TAC",
+			OpsName:  "TAC",
+			Type:     Analysis,
+			Variant:  VariantTAC,
+		},
+		&Swatch{
+			Filepath: maskPath,
+			Name:     fmt.Sprintf("TAC > %g%%", limit),
+			OpsName:  "TAC_limit",
+			Type:     Analysis,
+			Variant:  VariantTAC,
+		},
+	)
+	return nil
+}
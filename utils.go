@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"os"
@@ -17,16 +16,11 @@ import (
 	"strconv"
 	"strings"
 	"time"
-	"unicode/utf8"
 
-	"github.com/brandquad/dzi/assets"
-	dzi "github.com/brandquad/dzi/colorutils"
 	"github.com/davidbyttow/govips/v2/vips"
 	"github.com/lucasb-eyer/go-colorful"
-	"golang.org/x/text/encoding/charmap"
 )
 
-var defaultDecoder = charmap.Windows1251.NewDecoder()
 var re = regexp.MustCompile(`\"(?P<name>.*)\" .* ink = (?P<cmyk>.*) CMYK`)
 
 func syncToS3(assetId int, tmp string, c *Config) error {
@@ -76,6 +70,47 @@ func syncToS3(assetId int, tmp string, c *Config) error {
 	return nil
 }
 
+// fetchFromS3 copy files and folders of the processed asset from S3 into tmp,
+// items are relative to the asset folder, folders end with "/"
+func fetchFromS3(assetId int, tmp string, c *Config, items ...string) error {
+	st := time.Now()
+	log.Println("[>] Copy from S3:", c.S3Host, c.S3Bucket)
+	defer func() {
+		log.Printf("[<] Copy from S3, at %s", time.Since(st))
+	}()
+
+	if err := os.Setenv("MC_NO_COLOR", "1"); err != nil {
+		return err
+	}
+
+	aliasName := fmt.Sprintf("mediaquad%d", assetId)
+	if _, err := execCmd("mc", "alias", "set", aliasName, c.S3Host, c.S3Key, c.S3Secret); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := execCmd("mc", "alias", "rm", aliasName); err != nil {
+			log.Printf("[!] Error removing mc alias: %v", err)
+		}
+	}()
+
+	for _, item := range items {
+		from := fmt.Sprintf("%s/%s/%d/%s", aliasName, c.S3Bucket, assetId, item)
+		to := path.Join(tmp, item)
+		args := []string{"cp", from, to, "--quiet"}
+		if strings.HasSuffix(item, "/") {
+			to += "/"
+			args = []string{"cp", "-r", from, to, "--quiet"}
+		}
+		if err := os.MkdirAll(path.Dir(to), DefaultFolderPerm); err != nil {
+			return err
+		}
+		if _, err := execCmd("mc", args...); err != nil {
+			return fmt.Errorf("copy %s from S3: %w", item, err)
+		}
+	}
+	return nil
+}
+
 func cp(from, to string) error {
 	dir, _ := path.Split(to)
 	if err := os.MkdirAll(dir, DefaultFolderPerm); err != nil {
@@ -182,21 +217,33 @@ type channelsMap map[string]*channelFile
 type pageChannels map[int]channelsMap
 
 type channelFile struct {
-	RgbComponents string
-	Filepath      string
-	OpsName       string
-	IsColor       bool
+	RgbComponents  string
+	Library        string
+	LibraryName    string
+	NameConfidence float64
+	RawName        string
+	Charset        string
+	Source         SwatchSource
+	Lab            []float64
+	Cmyk           []float64
+	Filepath       string
+	OpsName        string
+	IsColor        bool
+	Variant        string
+	Overprint      string
+	Layers         []string
+	Profile        string
 }
 
-// callGS just run ghostscript
-func callGS(filename, output string, page *pageSize, device string, c *Config) (channelsMap, error) {
-	log.Printf("[!] Effective DPI for page %d is %d, dOverprint is %s, device is %s", page.PageNum, page.Dpi, c.Overprint, device)
+// runGS just run ghostscript, output of the command is returned
+func runGS(filename, output string, page *pageSize, device, overprintMode string, c *Config) ([]byte, error) {
+	log.Printf("[!] Effective DPI for page %d is %d, dOverprint is %s, device is %s", page.PageNum, page.Dpi, overprintMode, device)
 	var (
 		overprint string
 		dUsePDFX3 string = "-dUsePDFX3Profile=0"
 	)
-	if c.Overprint != "" {
-		overprint = fmt.Sprintf("-dOverprint=%s", c.Overprint)
+	if overprintMode != "" {
+		overprint = fmt.Sprintf("-dOverprint=%s", overprintMode)
 	}
 	if c.UsePDFX3 {
 		dUsePDFX3 = "-dUsePDFX3Profile=1"
@@ -244,17 +291,18 @@ func callGS(filename, output string, page *pageSize, device string, c *Config) (
 		return len(x) == 0
 	})
 
-	cmdOut, err := execCmd("gs", args...)
+	return execCmd("gs", args...)
+}
+
+// callGS run ghostscript and collect separation files written next to output with their colors
+func callGS(filename, output string, page *pageSize, device, overprintMode string, resolver *colorResolver, c *Config) (channelsMap, error) {
+	cmdOut, err := runGS(filename, output, page, device, overprintMode, c)
 	if err != nil {
 		return nil, err
 	}
 
 	var spots = make(channelsMap)
 
-	if device == "tiff32nc" {
-		return spots, nil
-	}
-
 	files, err := os.ReadDir(path.Dir(output))
 	if err != nil {
 		return nil, err
@@ -284,16 +332,10 @@ func callGS(filename, output string, page *pageSize, device string, c *Config) (
 		if strings.Contains(spotName, "%") {
 			// Need decode
 			spotNameUnescape, _ := url.QueryUnescape(spotName)
-			if len(spotNameUnescape) > 0 {
-				if !utf8.Valid([]byte(spotNameUnescape)) {
-					out, _ := defaultDecoder.Bytes([]byte(spotNameUnescape))
-					spotName = string(out)
-				} else {
-					spotName = spotNameUnescape
-				}
-			} else {
-				spotName = spotNameUnescape
-			}
+			decoded := decodeName([]byte(spotNameUnescape), c.CharsetHints)
+			spotName = decoded.Name
+			spotFile.RawName = decoded.Raw
+			spotFile.Charset = decoded.Charset
 
 			spotNameForFile := spotName
 			if strings.Contains(spotNameForFile, "/") {
@@ -312,8 +354,14 @@ func callGS(filename, output string, page *pageSize, device string, c *Config) (
 			spotFile.Filepath = path.Join(path.Dir(output), file.Name())
 			spotFile.OpsName = spotName
 		}
-		if v, ok := CMYK[strings.ToLower(spotName)]; ok {
-			spotFile.RgbComponents = v
+		if m := confident(resolver.books.Lookup(spotName)); m != nil {
+			spotFile.RgbComponents = m.Color.Hex()
+			spotFile.Library = m.Book.Title
+			spotFile.LibraryName = m.Color.Name
+			spotFile.NameConfidence = m.Confidence
+			spotFile.Lab = m.Color.Lab
+			spotFile.Cmyk = m.Color.CMYK
+			spotFile.Source = librarySource(m.Book)
 		}
 
 		spots[spotName] = spotFile
@@ -334,18 +382,9 @@ func callGS(filename, output string, page *pageSize, device string, c *Config) (
 				}
 			}
 
-			spotName := paramsMap["name"]
-			spotNameBytes := []byte(spotName)
-
-			if !utf8.Valid(spotNameBytes) {
-				o, e := defaultDecoder.Bytes([]byte(spotName))
-				if e == nil {
-					spotName = string((o))
-				}
-			}
-
-			if v, ok := assets.Pantones[strings.ToLower(spotName)]; ok {
-				spots[spotName].RgbComponents = rgb2hex(v)
+			spotName := decodeName([]byte(paramsMap["name"]), c.CharsetHints).Name
+			if _, ok := spots[spotName]; !ok {
+				log.Printf("[!] No separation file for %s", spotName)
 				continue
 			}
 
@@ -361,7 +400,25 @@ func callGS(filename, output string, page *pageSize, device string, c *Config) (
 			_y = _y * 100.0 / 32760.0
 			_k = _k * 100.0 / 32760.0
 
-			spots[spotName].RgbComponents = rgb2hex(dzi.Cmyk2rgb([]float64{_c, _m, _y, _k}))
+			cmyk := []float64{_c, _m, _y, _k}
+			spots[spotName].Cmyk = cmyk
+
+			if m := confident(resolver.books.LookupSpot(spotName)); m != nil {
+				spots[spotName].RgbComponents = m.Color.Hex()
+				spots[spotName].Library = m.Book.Title
+				spots[spotName].LibraryName = m.Color.Name
+				spots[spotName].NameConfidence = m.Confidence
+				spots[spotName].Lab = m.Color.Lab
+				spots[spotName].Source = librarySource(m.Book)
+				continue
+			}
+
+			spots[spotName].RgbComponents = resolver.cmykToHex(cmyk)
+			spots[spotName].Library = ""
+			spots[spotName].LibraryName = ""
+			spots[spotName].NameConfidence = 0
+			spots[spotName].Lab = nil
+			spots[spotName].Source = SourceSeparation
 		}
 	}
 	return spots, err
@@ -370,51 +427,3 @@ func callGS(filename, output string, page *pageSize, device string, c *Config) (
 func rgb2hex(rgb []int) string {
 	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
 }
-
-// esko2swatch convert Esko metadata to Swatch
-func esko2swatch(name, egname, egtype, book string, nr, ng, nb float64) Swatch {
-	var swatchName = name
-	if egtype == "pantone" {
-		switch book {
-		case "pms1000c":
-			swatchName = fmt.Sprintf("PANTONE %s C", egname)
-		case "pms1000u":
-			swatchName = fmt.Sprintf("PANTONE %s U", egname)
-		case "pms1000m":
-			swatchName = fmt.Sprintf("PANTONE %s M", egname)
-		case "goec":
-			swatchName = fmt.Sprintf("PANTONE %s C", egname)
-		case "goeu":
-			swatchName = fmt.Sprintf("PANTONE %s U", egname)
-		case "pmetc":
-			swatchName = fmt.Sprintf("PANTONE %s C", egname)
-		case "ppasc":
-			swatchName = fmt.Sprintf("PANTONE %s C", egname)
-		case "ppasu":
-			swatchName = fmt.Sprintf("PANTONE %s U", egname)
-
-		default:
-			swatchName = name
-		}
-	}
-
-	var swatchType SwatchType
-	switch egtype {
-	case "process":
-		swatchType = CmykComponent
-	case "pantone", "designer":
-		swatchType = SpotComponent
-	}
-
-	var R = 255 * nr / 1
-	var G = 255 * ng / 1
-	var B = 255 * nb / 1
-
-	return Swatch{
-		Filepath: "",
-		Name:     swatchName,
-		RBG:      fmt.Sprintf("#%02x%02x%02x", int(math.Round(R)), int(math.Round(G)), int(math.Round(B))),
-		Type:     swatchType,
-		NeedMate: true,
-	}
-}
@@ -0,0 +1,150 @@
+package dzi
+
+import (
+	"encoding/xml"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	dzi "github.com/brandquad/dzi/colorutils"
+)
+
+// getPagesXMP collect XMP packets of each page (page metadata and PieceInfo) over mutool and inspect.js script file
+func getPagesXMP(fileName string) (map[int][]string, error) {
+	buff, err := execCmd("mutool", "run", "inspect.js", "metadata", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	packets := make(map[int][]string)
+	var pageNum int
+	var packet []string
+	for _, line := range strings.Split(string(buff), "\n") {
+		switch {
+		case strings.HasPrefix(line, "%%Page "):
+			pageNum, _ = strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "%%Page ")))
+			packet = packet[:0]
+		case line == "%%EndPage":
+			if pageNum > 0 {
+				packets[pageNum] = append(packets[pageNum], strings.Join(packet, "\n"))
+			}
+			pageNum = 0
+		case pageNum > 0:
+			packet = append(packet, line)
+		}
+	}
+	return packets, nil
+}
+
+// parseXMP decode document or page XMP packet, broken packet gives empty metadata
+func parseXMP(xmlString string) (*pdfMeta, *pdfEgMeta) {
+	var d pdfMeta
+	var eg pdfEgMeta
+	if strings.TrimSpace(xmlString) == "" {
+		return &d, &eg
+	}
+	if err := xml.NewDecoder(strings.NewReader(xmlString)).Decode(&d); err != nil {
+		log.Printf("[!] Error decoding XMP: %v", err)
+		return &pdfMeta{}, &eg
+	}
+	if err := xml.NewDecoder(strings.NewReader(xmlString)).Decode(&eg); err != nil {
+		log.Printf("[!] Error decoding Esko XMP: %v", err)
+		return &d, &pdfEgMeta{}
+	}
+	return &d, &eg
+}
+
+// xmpSwatches return inks from Esko metadata or, when there are no Esko inks, from Illustrator swatch groups.
+// Process swatches are not plates and skipped. Tint swatches add their tint to the base ink. Mixed inks are
+// not plates too, they are recorded on their constituent inks: components of the mixed ink swatch,
+// otherwise the other inks of its group as in InDesign mixed ink groups.
+func xmpSwatches(d *pdfMeta, eg *pdfEgMeta, resolver *colorResolver) map[string]Swatch {
+	swatchMap := make(map[string]Swatch)
+	for _, e := range eg.Inks {
+		sw := esko2swatch(e)
+		swatchMap[sw.Name] = sw
+	}
+	if len(swatchMap) > 0 {
+		return swatchMap
+	}
+
+	for _, group := range d.SwatchGroups {
+		var mixes []xmpColorant
+		var inks []string
+		for _, s := range group.Colorants {
+			switch colorType := strings.ToUpper(s.Type); {
+			case colorType == "PROCESS":
+				continue
+			case strings.Contains(colorType, "MIX"):
+				mixes = append(mixes, s)
+			default:
+				addXMPColorant(swatchMap, group.GroupName, s, resolver)
+				inks = append(inks, s.SwatchName)
+			}
+		}
+
+		for _, mix := range mixes {
+			constituents := inks
+			if len(mix.Components) > 0 {
+				constituents = nil
+				for _, s := range mix.Components {
+					if strings.EqualFold(s.Type, "PROCESS") || swatchTypeByName(s.SwatchName) == CmykComponent {
+						continue
+					}
+					addXMPColorant(swatchMap, group.GroupName, s, resolver)
+					constituents = append(constituents, s.SwatchName)
+				}
+			}
+			for _, name := range constituents {
+				sw, ok := swatchMap[name]
+				if !ok || slices.Contains(sw.MixedInks, mix.SwatchName) {
+					continue
+				}
+				sw.MixedInks = append(sw.MixedInks, mix.SwatchName)
+				swatchMap[name] = sw
+			}
+		}
+	}
+	return swatchMap
+}
+
+// addXMPColorant add spot colorant of the swatch group to swatch map. Full strength swatch defines
+// the ink color, tint swatch only when there is no other one.
+func addXMPColorant(swatchMap map[string]Swatch, groupName string, s xmpColorant, resolver *colorResolver) {
+	tint := 100.0
+	if s.Tint != nil {
+		tint = *s.Tint
+	}
+
+	sw, exists := swatchMap[s.SwatchName]
+	if tint < 100 && !slices.Contains(sw.Tints, tint) {
+		sw.Tints = append(sw.Tints, tint)
+	}
+	if !exists || tint >= 100 {
+		var rgb string
+		var lab, cmyk []float64
+		switch strings.ToUpper(s.Mode) {
+		case "LAB":
+			lab = []float64{s.L, s.A, s.B}
+			rgb = rgb2hex(dzi.Lab2rgb(lab))
+		case "RGB":
+			rgb = rgb2hex([]int{s.Red, s.Green, s.Blue})
+		case "CMYK":
+			cmyk = []float64{s.Cyan, s.Magenta, s.Yellow, s.Black}
+			rgb = resolver.cmykToHex(cmyk)
+		}
+		if rgb != "" {
+			sw.Name = s.SwatchName
+			sw.RBG = rgb
+			sw.Type = SpotComponent
+			sw.Lab = lab
+			sw.Cmyk = cmyk
+			sw.Source = SourceXMP
+			sw.Group = groupName
+		}
+	}
+	if sw.Name != "" {
+		swatchMap[s.SwatchName] = sw
+	}
+}